@@ -25,6 +25,9 @@ var (
 	// ServiceImportCRD is the embedded YAML for the ServiceImport CRD
 	//go:embed multicluster.x-k8s.io_serviceimports.yaml
 	ServiceImportCRD []byte
+	// ClusterPropertyCRD is the embedded YAML for the ClusterProperty CRD
+	//go:embed multicluster.x-k8s.io_clusterproperties.yaml
+	ClusterPropertyCRD []byte
 )
 
 const (
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// reportdiff compares two conformance reports, printing the tests newly failing, passing and skipped in the later
+// one. It exits with status 1 if Required tests regressed, and 2 on errors.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/mcs-api/conformance/report"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s <before report> <after report>\n\n"+
+			"Compares two YAML or JSON conformance reports, exiting with status 1 if Required tests regressed.\n",
+			os.Args[0])
+	}
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	before, err := report.Load(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	after, err := report.Load(flag.Arg(1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	if printDiffs(os.Stdout, before, after) {
+		os.Exit(1)
+	}
+}
+
+// printDiffs prints the changes from before to after and returns true if Required tests regressed.
+func printDiffs(out io.Writer, before, after *report.Report) bool {
+	fmt.Fprintf(out, "Comparing %s to %s\n", describe(before), describe(after))
+
+	regressed := false
+
+	for _, diff := range report.Compare(before, after) {
+		fmt.Fprintf(out, "\n%s:\n", diff.Group)
+
+		if diff.Empty() {
+			fmt.Fprintln(out, "  No changes")
+			continue
+		}
+
+		printChanges(out, "Newly failing", diff.NewlyFailing)
+		printChanges(out, "Newly passing", diff.NewlyPassing)
+		printChanges(out, "Newly skipped", diff.NewlySkipped)
+
+		if diff.Group == report.RequiredGroup && diff.Regressed() {
+			regressed = true
+		}
+	}
+
+	if profiles := report.RegressedProfiles(before, after); len(profiles) > 0 {
+		fmt.Fprintf(out, "\nClaimed profiles no longer passing: %s\n", strings.Join(profiles, ", "))
+	}
+
+	if after.SuiteFailure != "" {
+		fmt.Fprintf(out, "\nThe suite failed: %s\n", after.SuiteFailure)
+		regressed = regressed || before.SuiteFailure == ""
+	}
+
+	return regressed
+}
+
+func printChanges(out io.Writer, title string, changes []report.Change) {
+	if len(changes) == 0 {
+		return
+	}
+
+	fmt.Fprintf(out, "  %s:\n", title)
+
+	for _, change := range changes {
+		fmt.Fprintf(out, "    - %s (%s -> %s)\n", change.Desc, change.Before, change.After)
+
+		if change.Message != "" {
+			fmt.Fprintf(out, "      %s\n", change.Message)
+		}
+	}
+}
+
+func describe(r *report.Report) string {
+	if r.Implementation.Project == "" {
+		return fmt.Sprintf("%d/%d passed", r.Passed, r.Total)
+	}
+
+	return fmt.Sprintf("%s %s (%d/%d passed)", r.Implementation.Project, r.Implementation.Version, r.Passed, r.Total)
+}
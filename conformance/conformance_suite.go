@@ -22,6 +22,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"maps"
 	"math/rand"
 	"slices"
 	"strings"
@@ -41,15 +42,19 @@ import (
 	rest "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	k8snet "k8s.io/utils/net"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
 	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
 	mcsclient "sigs.k8s.io/mcs-api/pkg/client/clientset/versioned"
 )
 
 type clusterClients struct {
 	name string
-	k8s  kubernetes.Interface
-	mcs  mcsclient.Interface
-	rest *rest.Config
+	// clusterID is the name of the cluster within the ClusterSet, as listed in ServiceImport status. It defaults
+	// to the kubeconfig cluster name if the cluster has no cluster ID ClusterProperty.
+	clusterID string
+	k8s       kubernetes.Interface
+	mcs       mcsclient.Interface
+	rest      *rest.Config
 }
 
 var (
@@ -62,6 +67,10 @@ var (
 	project                          string
 	version                          string
 	url                              string
+	reportDir                        string
+	reportFormats                    string
+	profileList                      string
+	featureList                      string
 )
 
 // TestConformance runs the conformance test.
@@ -87,9 +96,24 @@ func init() {
 	flag.StringVar(&project, "project", "", "Name of the MCS implementation project being tested")
 	flag.StringVar(&version, "version", "", "Version of the MCS implementation being tested")
 	flag.StringVar(&url, "url", "", "A URL pointing to the MCS implementation project or documentation")
+	flag.StringVar(&reportDir, "report-dir", ".", "The directory the conformance reports are written to, created if missing")
+	flag.StringVar(&reportFormats, "report-formats", "html,yaml", fmt.Sprintf(
+		"A comma-separated list of the conformance report formats to write, out of %s", strings.Join(slices.Sorted(maps.Keys(reportWriters)), ", ")))
+	flag.StringVar(&profileList, "profiles", "", fmt.Sprintf(
+		"A comma-separated list of the conformance profiles claimed by the implementation, out of %s. Only the tests of "+
+			"the claimed profiles and supported features are run; the %s profile is always claimed. All tests are run if "+
+			"neither profiles nor supported features are given", strings.Join(Profiles.Names(), ", "), CoreProfile))
+	flag.StringVar(&featureList, "supported-features", "", fmt.Sprintf(
+		"A comma-separated list of the features supported by the implementation in addition to those of the claimed "+
+			"profiles, out of %s. The %s features are in no profile and are only tested if given here or if all tests are run",
+		strings.Join(Profiles.Features, ", "), strings.Join(Profiles.UnprofiledFeatures(), ", ")))
 }
 
 var _ = BeforeSuite(func(ctx context.Context) {
+	_, err := reportWritersFor(reportFormats)
+	Expect(err).ToNot(HaveOccurred(), "Invalid --report-formats")
+	Expect(Profiles.Claim(profileList, featureList)).To(Succeed(), "Invalid --profiles or --supported-features")
+
 	Expect(setupClients(ctx)).To(Succeed(), "Test suite set up failed")
 })
 
@@ -143,7 +167,16 @@ func setupClients(ctx context.Context) error {
 				return fmt.Errorf("error listing ServiceImports on context %s: %w. Is the MCS API installed?", name, err)
 			}
 
-			clients[i] = clusterClients{name: name, k8s: k8sClient, mcs: mcsClient, rest: restConfig}
+			clusterID, err := lookupClusterID(ctx, mcsClient)
+			if err != nil {
+				return fmt.Errorf("error retrieving the cluster ID on context %s: %w", name, err)
+			}
+
+			if clusterID == "" {
+				clusterID = name
+			}
+
+			clients[i] = clusterClients{name: name, clusterID: clusterID, k8s: k8sClient, mcs: mcsClient, rest: restConfig}
 
 			return nil
 		}()
@@ -154,6 +187,22 @@ func setupClients(ctx context.Context) error {
 	return errors.Join(accumulatedErrors...)
 }
 
+// lookupClusterID returns the value of the cluster ID ClusterProperty, or an empty string if the ClusterProperty API
+// isn't installed or the property isn't set.
+func lookupClusterID(ctx context.Context, mcsClient mcsclient.Interface) (string, error) {
+	property, err := mcsClient.MulticlusterV1alpha1().ClusterProperties().Get(ctx, v1alpha1.ClusterIDPropertyName,
+		metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return property.Spec.Value, nil
+}
+
 type testDriver struct {
 	namespace          string
 	helloService       *corev1.Service
@@ -491,6 +540,21 @@ func dnsRecordTypeOf(f corev1.IPFamily) string {
 	return "AAAA"
 }
 
+// clusterIPFamilies returns the IP families supported by a cluster, determined from the cluster IPs allocated on a
+// dry-run creation of a service preferring dual-stack.
+func clusterIPFamilies(ctx context.Context, c *clusterClients) []corev1.IPFamily {
+	svc, err := c.k8s.CoreV1().Services(metav1.NamespaceDefault).Create(ctx, newHelloService(),
+		metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+	Expect(err).ToNot(HaveOccurred())
+
+	families := make([]corev1.IPFamily, 0, len(svc.Spec.ClusterIPs))
+	for _, ip := range svc.Spec.ClusterIPs {
+		families = append(families, ipFamilyOf(ip))
+	}
+
+	return families
+}
+
 func ipFamilyOf(ip string) corev1.IPFamily {
 	f := k8snet.IPFamilyOfString(ip)
 	Expect(f).NotTo(Equal(k8snet.IPFamilyUnknown))
@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	"sigs.k8s.io/mcs-api/conformance/report"
+)
+
+// CoreProfile is the profile of the tests requiring no optional feature.
+const CoreProfile = report.CoreProfile
+
+// Profiles are the conformance profiles, claimed with --profiles, and the features of the tests. A profile includes
+// every feature its tests rely on: the DualStack test checks the ServiceImport Ready condition and the Headless tests
+// resolve DNS records. The Connectivity, EndpointSlice and StrictPortConflict features are in no profile, their tests
+// are only run when all tests are or when the features are given with --supported-features.
+var Profiles = &report.ProfileSet{
+	Definitions: []report.ProfileDefinition{
+		{Name: CoreProfile},
+		{Name: "DNS", Features: []string{DNSLabel}},
+		{Name: "Headless", Features: []string{HeadlessLabel, DNSLabel}},
+		{Name: "DualStack", Features: []string{DualStackLabel, ReadyConditionLabel}},
+		{Name: "ExportedMetadata", Features: []string{ExportedLabelsLabel}},
+	},
+	Features: []string{
+		DNSLabel,
+		ConnectivityLabel,
+		HeadlessLabel,
+		EndpointSliceLabel,
+		ExportedLabelsLabel,
+		StrictPortConflictLabel,
+		ReadyConditionLabel,
+		DualStackLabel,
+	},
+}
+
+var _ = BeforeEach(func() {
+	if missing := Profiles.UnsupportedFeatures(CurrentSpecReport().Labels()); len(missing) > 0 {
+		Skip(fmt.Sprintf("This test requires the unsupported features %s - skipping", strings.Join(missing, ", ")))
+	}
+})
@@ -20,8 +20,6 @@ import (
 	_ "embed" // Needed for go:embed
 	"errors"
 	"fmt"
-	"html/template"
-	"os"
 	"regexp"
 	"slices"
 	"strings"
@@ -29,14 +27,13 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	"github.com/onsi/ginkgo/v2/types"
-	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/matchers"
-	"gopkg.in/yaml.v3"
+	"sigs.k8s.io/mcs-api/conformance/report"
 )
 
 const (
-	OptionalLabel            = "Optional"
-	RequiredLabel            = "Required"
+	OptionalLabel            = report.OptionalGroup
+	RequiredLabel            = report.RequiredGroup
 	DNSLabel                 = "DNS"
 	ConnectivityLabel        = "Connectivity"
 	ClusterIPLabel           = "ClusterIP"
@@ -45,6 +42,8 @@ const (
 	EndpointSliceLabel       = "EndpointSlice"
 	ExportedLabelsLabel      = "ExportedLabels"
 	StrictPortConflictLabel  = "StrictPortConflict"
+	ReadyConditionLabel      = "ReadyCondition"
+	DualStackLabel           = "DualStack"
 	SpecRefReportEntry       = "spec-ref"
 	NonConformantReportEntry = "non-conformant"
 )
@@ -57,29 +56,6 @@ var reportingLabels = []string{
 //go:embed report_template.gohtml
 var reportHTML string
 
-type testInfo struct {
-	Desc       string
-	Ref        string
-	Labels     []string
-	Passed     bool
-	Failed     bool
-	Skipped    bool
-	Conformant bool
-	Message    string
-}
-
-type testGrouping struct {
-	Name  string
-	Tests []testInfo
-}
-
-type implementationInfo struct {
-	Organization string
-	Project      string
-	Version      string
-	URL          string
-}
-
 var (
 	errorRegEx *regexp.Regexp
 	// currentSpecNonConformanceMsg holds the last non-conformance message emitted by the current spec. Using
@@ -149,11 +125,11 @@ var _ = ReportAfterEach(func(specReport SpecReport) {
 	}
 })
 
-var _ = ReportAfterSuite("MCS conformance report", func(report Report) {
-	testGroupMap := map[string]*testGrouping{}
+var _ = ReportAfterSuite("MCS conformance report", func(suiteReport Report) {
+	testGroupMap := map[string]*report.Group{}
 	suiteFailure := ""
 
-	for _, specReport := range report.SpecReports {
+	for _, specReport := range suiteReport.SpecReports {
 		if specReport.LeafNodeType == types.NodeTypeBeforeSuite && specReport.State == types.SpecStateFailed {
 			suiteFailure = parseFailureMessage(specReport.FailureMessage())
 			continue
@@ -169,12 +145,12 @@ var _ = ReportAfterSuite("MCS conformance report", func(report Report) {
 			}
 
 			if testGroupMap[label] == nil {
-				testGroupMap[label] = &testGrouping{
+				testGroupMap[label] = &report.Group{
 					Name: label,
 				}
 			}
 
-			info := testInfo{
+			info := report.Test{
 				Desc:       strings.TrimSpace(specReport.FullText()),
 				Conformant: true,
 			}
@@ -213,18 +189,14 @@ var _ = ReportAfterSuite("MCS conformance report", func(report Report) {
 
 			info.Passed = !info.Failed && !info.Skipped && info.Conformant
 
-			if info.Message != "" {
-				info.Message = " - " + info.Message
-			}
-
 			testGroupMap[label].Tests = append(testGroupMap[label].Tests, info)
 		}
 	}
 
-	testGroups := []testGrouping{}
+	testGroups := []report.Group{}
 	for _, l := range reportingLabels {
 		if testGroupMap[l] != nil {
-			slices.SortFunc(testGroupMap[l].Tests, func(a, b testInfo) int {
+			slices.SortFunc(testGroupMap[l].Tests, func(a, b report.Test) int {
 				if cmp := slices.Compare(a.Labels, b.Labels); cmp != 0 {
 					return cmp
 				}
@@ -245,41 +217,26 @@ var _ = ReportAfterSuite("MCS conformance report", func(report Report) {
 		}
 	}
 
-	data := struct {
-		Groups         []testGrouping
-		SuiteFailure   string
-		DNSDomain      string
-		Passed         int
-		Total          int
-		Implementation implementationInfo
-	}{
-		Groups:       testGroups,
-		SuiteFailure: suiteFailure,
-		DNSDomain:    dnsDomain,
-		Passed:       passedTests,
-		Total:        totalTests,
-		Implementation: implementationInfo{
+	data := &report.Report{
+		SchemaVersion: report.SchemaVersion,
+		Groups:        testGroups,
+		SuiteFailure:  suiteFailure,
+		DNSDomain:     dnsDomain,
+		Passed:        passedTests,
+		Total:         totalTests,
+		Implementation: report.Implementation{
 			Organization: organization,
 			Project:      project,
 			Version:      version,
 			URL:          url,
 		},
+		Profiles:          Profiles.Results(testGroups),
+		SupportedFeatures: Profiles.SupportedFeatures(),
 	}
 
-	out, err := os.Create("report.html")
-	Expect(err).To(Succeed())
-
-	tmpl, err := template.New("report").Parse(reportHTML)
-	Expect(err).To(Succeed())
-
-	err = tmpl.Execute(out, data)
-	Expect(err).To(Succeed())
-
-	yamlOut, err := os.Create("report.yaml")
-	Expect(err).To(Succeed())
-
-	err = yaml.NewEncoder(yamlOut).Encode(data)
-	Expect(err).To(Succeed())
+	if err := writeReports(reportDir, reportFormats, data); err != nil {
+		Fail(fmt.Sprintf("Error writing the conformance report: %v", err))
+	}
 })
 
 func parseFailureMessage(s string) string {
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+// Status is the outcome of a test.
+type Status string
+
+const (
+	StatusPassed        Status = "Passed"
+	StatusNonConformant Status = "NonConformant"
+	// StatusUnknown is the status of a test which failed without determining conformance.
+	StatusUnknown Status = "Unknown"
+	StatusSkipped Status = "Skipped"
+	// StatusMissing is the status of a test absent from a report.
+	StatusMissing Status = "Missing"
+)
+
+// Status returns the outcome of the test.
+func (t *Test) Status() Status {
+	switch {
+	case t.Skipped:
+		return StatusSkipped
+	case t.Failed:
+		return StatusUnknown
+	case !t.Conformant:
+		return StatusNonConformant
+	case t.Passed:
+		return StatusPassed
+	}
+
+	return StatusUnknown
+}
+
+func (s Status) failing() bool {
+	return s == StatusNonConformant || s == StatusUnknown
+}
+
+// Change is a test whose status differs between two reports.
+type Change struct {
+	Desc   string
+	Before Status
+	After  Status
+	// Message is the message of the test in the later report.
+	Message string
+}
+
+// Diff holds the changes of the tests of a group between two reports.
+type Diff struct {
+	Group        string
+	NewlyFailing []Change
+	NewlyPassing []Change
+	NewlySkipped []Change
+}
+
+// Regressed returns true if tests of the group are newly failing.
+func (d *Diff) Regressed() bool {
+	return len(d.NewlyFailing) > 0
+}
+
+// Empty returns true if no test of the group changed.
+func (d *Diff) Empty() bool {
+	return len(d.NewlyFailing) == 0 && len(d.NewlyPassing) == 0 && len(d.NewlySkipped) == 0
+}
+
+// Compare returns the changes from before to after of each group in after, in order. Tests are matched by group and
+// description; tests no longer in after are ignored.
+func Compare(before, after *Report) []Diff {
+	beforeStatuses := map[string]map[string]Status{}
+
+	for _, group := range before.Groups {
+		beforeStatuses[group.Name] = map[string]Status{}
+		for i := range group.Tests {
+			beforeStatuses[group.Name][group.Tests[i].Desc] = group.Tests[i].Status()
+		}
+	}
+
+	diffs := make([]Diff, 0, len(after.Groups))
+
+	for _, group := range after.Groups {
+		diff := Diff{Group: group.Name}
+
+		for i := range group.Tests {
+			test := &group.Tests[i]
+
+			beforeStatus, found := beforeStatuses[group.Name][test.Desc]
+			if !found {
+				beforeStatus = StatusMissing
+			}
+
+			afterStatus := test.Status()
+			change := Change{Desc: test.Desc, Before: beforeStatus, After: afterStatus, Message: test.Message}
+
+			switch {
+			case afterStatus.failing() && !beforeStatus.failing():
+				diff.NewlyFailing = append(diff.NewlyFailing, change)
+			case afterStatus == StatusPassed && beforeStatus != StatusPassed:
+				diff.NewlyPassing = append(diff.NewlyPassing, change)
+			case afterStatus == StatusSkipped && beforeStatus != StatusSkipped:
+				diff.NewlySkipped = append(diff.NewlySkipped, change)
+			}
+		}
+
+		diffs = append(diffs, diff)
+	}
+
+	return diffs
+}
+
+// RegressedProfiles returns the names of the profiles claimed in after which passed in before but not in after.
+func RegressedProfiles(before, after *Report) []string {
+	passedBefore := map[string]bool{}
+	for _, profile := range before.Profiles {
+		passedBefore[profile.Name] = profile.Passed
+	}
+
+	var names []string
+
+	for _, profile := range after.Profiles {
+		if profile.Claimed && !profile.Passed && passedBefore[profile.Name] {
+			names = append(names, profile.Name)
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string           `xml:"name,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	Errors     int              `xml:"errors,attr"`
+	Skipped    int              `xml:"skipped,attr"`
+	Properties *junitProperties `xml:"properties,omitempty"`
+	TestCases  []junitTestCase  `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name       string           `xml:"name,attr"`
+	Classname  string           `xml:"classname,attr"`
+	Properties *junitProperties `xml:"properties,omitempty"`
+	Skipped    *junitResult     `xml:"skipped,omitempty"`
+	Failure    *junitResult     `xml:"failure,omitempty"`
+	Error      *junitResult     `xml:"error,omitempty"`
+}
+
+type junitProperties struct {
+	Properties []junitProperty `xml:"property"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitResult struct {
+	Type    string `xml:"type,attr,omitempty"`
+	Message string `xml:"message,attr"`
+}
+
+// WriteJUnit writes a JUnit test suite per classification (Required or Optional). Each test case carries its
+// classification, labels and KEP spec reference as properties. Non-conformance is reported as a failure, and a
+// failure to determine conformance as an error.
+func WriteJUnit(out io.Writer, r *Report) error {
+	suites := junitTestSuites{Name: "MCS conformance"}
+
+	if r.SuiteFailure != "" {
+		suites.Tests++
+		suites.Errors++
+		suites.Suites = append(suites.Suites, junitTestSuite{
+			Name:   "Setup",
+			Tests:  1,
+			Errors: 1,
+			TestCases: []junitTestCase{{
+				Name:      "Test suite set up",
+				Classname: "Setup",
+				Error:     &junitResult{Message: r.SuiteFailure},
+			}},
+		})
+	}
+
+	claimed := profilesWhere(r.Profiles, func(p Profile) bool { return p.Claimed })
+	passed := profilesWhere(r.Profiles, func(p Profile) bool { return p.Passed })
+
+	for _, group := range r.Groups {
+		suite := junitTestSuite{
+			Name:  group.Name,
+			Tests: len(group.Tests),
+			Properties: &junitProperties{Properties: []junitProperty{
+				{Name: "implementation.organization", Value: r.Implementation.Organization},
+				{Name: "implementation.project", Value: r.Implementation.Project},
+				{Name: "implementation.version", Value: r.Implementation.Version},
+				{Name: "implementation.url", Value: r.Implementation.URL},
+				{Name: "dns-domain", Value: r.DNSDomain},
+				{Name: "claimed-profiles", Value: strings.Join(claimed, ",")},
+				{Name: "passed-profiles", Value: strings.Join(passed, ",")},
+				{Name: "supported-features", Value: strings.Join(r.SupportedFeatures, ",")},
+			}},
+		}
+
+		for _, test := range group.Tests {
+			testCase := junitTestCase{
+				Name:      test.Desc,
+				Classname: group.Name,
+				Properties: &junitProperties{Properties: []junitProperty{
+					{Name: "classification", Value: group.Name},
+					{Name: "labels", Value: strings.Join(test.Labels, ",")},
+					{Name: "spec-ref", Value: test.Ref},
+				}},
+			}
+
+			switch {
+			case test.Skipped:
+				suite.Skipped++
+				testCase.Skipped = &junitResult{Message: test.Message}
+			case test.Failed:
+				suite.Errors++
+				testCase.Error = &junitResult{Type: "Unknown", Message: test.Message}
+			case !test.Conformant:
+				suite.Failures++
+				testCase.Failure = &junitResult{Type: "NonConformant", Message: test.Message}
+			}
+
+			suite.TestCases = append(suite.TestCases, testCase)
+		}
+
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Errors += suite.Errors
+		suites.Skipped += suite.Skipped
+		suites.Suites = append(suites.Suites, suite)
+	}
+
+	if _, err := io.WriteString(out, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(out)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(out, "\n")
+
+	return err
+}
+
+func profilesWhere(profiles []Profile, include func(Profile) bool) []string {
+	var names []string
+
+	for _, profile := range profiles {
+		if include(profile) {
+			names = append(names, profile.Name)
+		}
+	}
+
+	return names
+}
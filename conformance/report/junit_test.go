@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func properties(p *junitProperties) map[string]string {
+	values := map[string]string{}
+	if p == nil {
+		return values
+	}
+
+	for _, property := range p.Properties {
+		values[property.Name] = property.Value
+	}
+
+	return values
+}
+
+func TestWriteJUnit(t *testing.T) {
+	ref := "https://github.com/kubernetes/enhancements/tree/master/keps/sig-multicluster/1645-multi-cluster-services-api#dns"
+	r := newReport(
+		Test{Desc: "passed", Ref: ref, Labels: []string{"DNS", "ClusterIP"}, Passed: true, Conformant: true},
+		nonConformant("non-conformant"),
+		unknown("unknown"),
+		skipped("skipped"),
+	)
+	r.SuiteFailure = "no clusters"
+	r.Implementation = Implementation{Organization: "org", Project: "mcs", Version: "v1.0.0", URL: "https://example.com"}
+	r.Profiles = []Profile{{Name: "Core", Claimed: true, Passed: true}, {Name: "DNS", Claimed: true}, {Name: "Headless"}}
+	r.SupportedFeatures = []string{"DNS"}
+
+	var out bytes.Buffer
+	if err := WriteJUnit(&out, r); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(out.String(), xml.Header) {
+		t.Errorf("expected an XML header, got %q", out.String())
+	}
+
+	var suites junitTestSuites
+	if err := xml.Unmarshal(out.Bytes(), &suites); err != nil {
+		t.Fatal(err)
+	}
+
+	if suites.Tests != 6 || suites.Failures != 1 || suites.Errors != 2 || suites.Skipped != 1 {
+		t.Errorf("unexpected counts %d tests, %d failures, %d errors, %d skipped",
+			suites.Tests, suites.Failures, suites.Errors, suites.Skipped)
+	}
+
+	if len(suites.Suites) != 3 {
+		t.Fatalf("expected a setup suite and a suite per group, got %+v", suites.Suites)
+	}
+
+	setup := suites.Suites[0]
+	if setup.Name != "Setup" || setup.Errors != 1 || setup.TestCases[0].Error == nil ||
+		setup.TestCases[0].Error.Message != "no clusters" {
+		t.Errorf("unexpected setup suite %+v", setup)
+	}
+
+	required := suites.Suites[1]
+	if required.Name != RequiredGroup || required.Tests != 4 || required.Failures != 1 || required.Errors != 1 ||
+		required.Skipped != 1 {
+		t.Errorf("unexpected Required suite counts %+v", required)
+	}
+
+	suiteProperties := properties(required.Properties)
+	for name, value := range map[string]string{
+		"implementation.project": "mcs",
+		"implementation.version": "v1.0.0",
+		"claimed-profiles":       "Core,DNS",
+		"passed-profiles":        "Core",
+		"supported-features":     "DNS",
+	} {
+		if suiteProperties[name] != value {
+			t.Errorf("expected suite property %s=%q, got %q", name, value, suiteProperties[name])
+		}
+	}
+
+	testCases := map[string]junitTestCase{}
+	for _, testCase := range required.TestCases {
+		testCases[testCase.Name] = testCase
+	}
+
+	passed := testCases["passed"]
+	if passed.Failure != nil || passed.Error != nil || passed.Skipped != nil {
+		t.Errorf("expected the passed test to have no result, got %+v", passed)
+	}
+
+	caseProperties := properties(passed.Properties)
+	if caseProperties["classification"] != RequiredGroup || caseProperties["labels"] != "DNS,ClusterIP" ||
+		caseProperties["spec-ref"] != ref {
+		t.Errorf("unexpected test case properties %v", caseProperties)
+	}
+
+	if failure := testCases["non-conformant"].Failure; failure == nil || failure.Type != "NonConformant" ||
+		failure.Message != "mismatch" || testCases["non-conformant"].Error != nil {
+		t.Errorf("expected a NonConformant failure, got %+v", testCases["non-conformant"])
+	}
+
+	if testCaseError := testCases["unknown"].Error; testCaseError == nil || testCaseError.Type != "Unknown" ||
+		testCaseError.Message != "timeout" || testCases["unknown"].Failure != nil {
+		t.Errorf("expected an Unknown error, got %+v", testCases["unknown"])
+	}
+
+	if testCases["skipped"].Skipped == nil {
+		t.Errorf("expected a skipped test, got %+v", testCases["skipped"])
+	}
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// CoreProfile is the profile of the tests requiring no optional feature.
+const CoreProfile = "Core"
+
+// ProfileDefinition is a named set of features an implementation can claim conformance to.
+type ProfileDefinition struct {
+	Name string
+	// Features are the labels of the tests covered by the profile. A test requiring several features is covered by
+	// each profile whose features it requires. The Core profile has no features and covers the tests requiring none.
+	Features []string
+}
+
+// ProfileSet holds the conformance profiles and the profiles and features claimed by an implementation. Until Claim
+// is called, no profile is claimed and all features are supported.
+type ProfileSet struct {
+	Definitions []ProfileDefinition
+	// Features are the labels of the tests requiring an optional feature of the implementation, as opposed to the
+	// classification and service type labels.
+	Features []string
+
+	// claimed holds the names of the claimed profiles.
+	claimed map[string]bool
+	// supported holds the features tests are run for, all of them if nil.
+	supported map[string]bool
+}
+
+// Claim sets the claimed profiles and supported features from the comma-separated profiles and features. All
+// profiles are claimed and all features supported if both are empty; otherwise the Core profile is always claimed,
+// along with the features of the claimed profiles.
+func (s *ProfileSet) Claim(profiles, features string) error {
+	s.claimed = map[string]bool{}
+	s.supported = nil
+
+	if profiles == "" && features == "" {
+		for _, profile := range s.Definitions {
+			s.claimed[profile.Name] = true
+		}
+
+		return nil
+	}
+
+	s.claimed[CoreProfile] = true
+	s.supported = map[string]bool{}
+
+	for _, name := range splitList(profiles) {
+		i := slices.IndexFunc(s.Definitions, func(p ProfileDefinition) bool { return p.Name == name })
+		if i < 0 {
+			return fmt.Errorf("unknown profile %q, expected one of %s", name, strings.Join(s.Names(), ", "))
+		}
+
+		s.claimed[name] = true
+		for _, feature := range s.Definitions[i].Features {
+			s.supported[feature] = true
+		}
+	}
+
+	for _, feature := range splitList(features) {
+		if !slices.Contains(s.Features, feature) {
+			return fmt.Errorf("unknown feature %q, expected one of %s", feature, strings.Join(s.Features, ", "))
+		}
+
+		s.supported[feature] = true
+	}
+
+	return nil
+}
+
+func splitList(s string) []string {
+	var items []string
+
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// Names returns the names of the profiles.
+func (s *ProfileSet) Names() []string {
+	names := make([]string, 0, len(s.Definitions))
+	for _, profile := range s.Definitions {
+		names = append(names, profile.Name)
+	}
+
+	return names
+}
+
+// RequiredFeatures returns the features required by a test with the given labels.
+func (s *ProfileSet) RequiredFeatures(labels []string) []string {
+	var features []string
+
+	for _, label := range labels {
+		if slices.Contains(s.Features, label) {
+			features = append(features, label)
+		}
+	}
+
+	return features
+}
+
+// UnsupportedFeatures returns the features required by a test with the given labels which aren't supported.
+func (s *ProfileSet) UnsupportedFeatures(labels []string) []string {
+	if s.supported == nil {
+		return nil
+	}
+
+	return slices.DeleteFunc(s.RequiredFeatures(labels), func(feature string) bool {
+		return s.supported[feature]
+	})
+}
+
+// SupportedFeatures returns the sorted features tests are run for.
+func (s *ProfileSet) SupportedFeatures() []string {
+	if s.supported == nil {
+		return slices.Sorted(slices.Values(s.Features))
+	}
+
+	return slices.Sorted(maps.Keys(s.supported))
+}
+
+// UnprofiledFeatures returns the sorted features of no profile, which can only be supported with Claim's features.
+func (s *ProfileSet) UnprofiledFeatures() []string {
+	return slices.Sorted(slices.Values(slices.DeleteFunc(slices.Clone(s.Features), func(feature string) bool {
+		return slices.ContainsFunc(s.Definitions, func(p ProfileDefinition) bool {
+			return slices.Contains(p.Features, feature)
+		})
+	})))
+}
+
+func (p *ProfileDefinition) covers(features []string) bool {
+	if len(p.Features) == 0 {
+		return len(features) == 0
+	}
+
+	for _, feature := range p.Features {
+		if !slices.Contains(features, feature) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Results returns the result of each profile: a profile passed if any of the tests it covers passed and none
+// failed.
+func (s *ProfileSet) Results(groups []Group) []Profile {
+	results := make([]Profile, 0, len(s.Definitions))
+
+	for _, profile := range s.Definitions {
+		passed, failed := false, false
+
+		for _, group := range groups {
+			for i := range group.Tests {
+				if !profile.covers(s.RequiredFeatures(group.Tests[i].Labels)) {
+					continue
+				}
+
+				switch status := group.Tests[i].Status(); {
+				case status == StatusPassed:
+					passed = true
+				case status.failing():
+					failed = true
+				}
+			}
+		}
+
+		results = append(results, Profile{
+			Name:    profile.Name,
+			Claimed: s.claimed[profile.Name],
+			Passed:  passed && !failed,
+		})
+	}
+
+	return results
+}
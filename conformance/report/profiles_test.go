@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"slices"
+	"testing"
+)
+
+func newProfileSet() *ProfileSet {
+	return &ProfileSet{
+		Definitions: []ProfileDefinition{
+			{Name: CoreProfile},
+			{Name: "DNS", Features: []string{"DNS"}},
+			{Name: "DualStack", Features: []string{"DualStack", "ReadyCondition"}},
+		},
+		Features: []string{"DNS", "ReadyCondition", "DualStack"},
+	}
+}
+
+func claimed(results []Profile) []string {
+	var names []string
+	for _, result := range results {
+		if result.Claimed {
+			names = append(names, result.Name)
+		}
+	}
+
+	return names
+}
+
+func TestProfileSetClaim(t *testing.T) {
+	tests := []struct {
+		name              string
+		profiles          string
+		features          string
+		expectedClaimed   []string
+		expectedSupported []string
+		expectedError     bool
+	}{
+		{
+			name:              "nothing claimed",
+			expectedClaimed:   []string{CoreProfile, "DNS", "DualStack"},
+			expectedSupported: []string{"DNS", "DualStack", "ReadyCondition"},
+		},
+		{
+			name:              "profiles",
+			profiles:          "DualStack, ,",
+			expectedClaimed:   []string{CoreProfile, "DualStack"},
+			expectedSupported: []string{"DualStack", "ReadyCondition"},
+		},
+		{
+			name:              "features",
+			features:          "DNS",
+			expectedClaimed:   []string{CoreProfile},
+			expectedSupported: []string{"DNS"},
+		},
+		{
+			name:          "unknown profile",
+			profiles:      "DNS,IPv6",
+			expectedError: true,
+		},
+		{
+			name:          "unknown feature",
+			features:      "Headless",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newProfileSet()
+
+			err := s.Claim(tt.profiles, tt.features)
+			if tt.expectedError {
+				if err == nil {
+					t.Error("expected an error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := claimed(s.Results(nil)); !slices.Equal(got, tt.expectedClaimed) {
+				t.Errorf("expected claimed profiles %v, got %v", tt.expectedClaimed, got)
+			}
+
+			if got := s.SupportedFeatures(); !slices.Equal(got, tt.expectedSupported) {
+				t.Errorf("expected supported features %v, got %v", tt.expectedSupported, got)
+			}
+		})
+	}
+}
+
+func TestProfileSetUnsupportedFeatures(t *testing.T) {
+	s := newProfileSet()
+
+	labels := []string{OptionalGroup, "DNS", "ClusterIP", "ReadyCondition"}
+	if got := s.UnsupportedFeatures(labels); len(got) != 0 {
+		t.Errorf("expected all features to be supported before claiming, got %v", got)
+	}
+
+	if err := s.Claim("DNS", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := s.UnsupportedFeatures(labels); !slices.Equal(got, []string{"ReadyCondition"}) {
+		t.Errorf("expected ReadyCondition to be unsupported, got %v", got)
+	}
+
+	if got := s.UnsupportedFeatures([]string{RequiredGroup, "ClusterIP"}); len(got) != 0 {
+		t.Errorf("expected a test requiring no feature to be supported, got %v", got)
+	}
+}
+
+func TestProfileSetUnprofiledFeatures(t *testing.T) {
+	s := newProfileSet()
+	s.Features = append(s.Features, "StrictPortConflict", "Connectivity")
+
+	if got := s.UnprofiledFeatures(); !slices.Equal(got, []string{"Connectivity", "StrictPortConflict"}) {
+		t.Errorf("expected the features of no profile to be Connectivity and StrictPortConflict, got %v", got)
+	}
+}
+
+func TestProfileSetResults(t *testing.T) {
+	withLabels := func(test Test, labels ...string) Test {
+		test.Labels = labels
+		return test
+	}
+
+	s := newProfileSet()
+	if err := s.Claim("DualStack", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	results := s.Results([]Group{
+		{Name: RequiredGroup, Tests: []Test{passed("core"), skipped("skipped core")}},
+		{Name: OptionalGroup, Tests: []Test{
+			withLabels(passed("dns"), "DNS"),
+			withLabels(nonConformant("dns records"), "DNS", "ClusterIP"),
+			withLabels(passed("ready"), "ReadyCondition"),
+			withLabels(passed("dual-stack"), "DualStack", "ReadyCondition"),
+			withLabels(skipped("dns over dual-stack"), "DNS", "DualStack", "ReadyCondition"),
+		}},
+	})
+
+	expected := []Profile{
+		{Name: CoreProfile, Claimed: true, Passed: true},
+		{Name: "DNS", Passed: false},
+		{Name: "DualStack", Claimed: true, Passed: true},
+	}
+	if !slices.Equal(results, expected) {
+		t.Errorf("expected profile results %+v, got %+v", expected, results)
+	}
+
+	results = s.Results([]Group{{Name: OptionalGroup, Tests: []Test{withLabels(unknown("dual-stack"), "DualStack", "ReadyCondition")}}})
+	if results[0].Passed || results[2].Passed {
+		t.Errorf("expected profiles without passed tests, or with failed tests, to fail, got %+v", results)
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package report defines the report written by the MCS conformance suite and its conformance profiles, writes the
+// report in JUnit format, and compares reports across runs.
+package report
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SchemaVersion is the version of report-v1.schema.json, describing the YAML and JSON reports. It must be bumped,
+// alongside a new schema, on incompatible changes to Report.
+const SchemaVersion = "v1"
+
+const (
+	// RequiredGroup is the name of the group of tests an implementation must pass to be conformant.
+	RequiredGroup = "Required"
+	// OptionalGroup is the name of the group of tests of optional features.
+	OptionalGroup = "Optional"
+)
+
+// Report is the conformance report written in YAML and JSON by the conformance suite.
+type Report struct {
+	SchemaVersion  string         `json:"schemaVersion" yaml:"schemaVersion"`
+	Groups         []Group        `json:"groups" yaml:"groups"`
+	SuiteFailure   string         `json:"suitefailure" yaml:"suitefailure"`
+	DNSDomain      string         `json:"dnsdomain" yaml:"dnsdomain"`
+	Passed         int            `json:"passed" yaml:"passed"`
+	Total          int            `json:"total" yaml:"total"`
+	Implementation Implementation `json:"implementation" yaml:"implementation"`
+	// Profiles are the results of the conformance profiles.
+	Profiles []Profile `json:"profiles,omitempty" yaml:"profiles,omitempty"`
+	// SupportedFeatures are the features the tests were run for.
+	SupportedFeatures []string `json:"supportedFeatures,omitempty" yaml:"supportedFeatures,omitempty"`
+}
+
+// Profile is the result of a conformance profile.
+type Profile struct {
+	Name string `json:"name" yaml:"name"`
+	// Claimed is true if the implementation claimed support of the profile.
+	Claimed bool `json:"claimed" yaml:"claimed"`
+	// Passed is true if tests of the profile passed and none failed.
+	Passed bool `json:"passed" yaml:"passed"`
+}
+
+// Group holds the tests of a classification, either RequiredGroup or OptionalGroup.
+type Group struct {
+	Name  string `json:"name" yaml:"name"`
+	Tests []Test `json:"tests" yaml:"tests"`
+}
+
+// Test is the result of a conformance test.
+type Test struct {
+	Desc string `json:"desc" yaml:"desc"`
+	// Ref is the reference to the section of the KEP checked by the test.
+	Ref string `json:"ref,omitempty" yaml:"ref"`
+	// Labels are the labels of the test, other than its classification.
+	Labels []string `json:"labels,omitempty" yaml:"labels"`
+	Passed bool     `json:"passed" yaml:"passed"`
+	// Failed is true if the test failed without determining conformance.
+	Failed  bool `json:"failed" yaml:"failed"`
+	Skipped bool `json:"skipped" yaml:"skipped"`
+	// Conformant is false if the test found the implementation to be non-conformant.
+	Conformant bool   `json:"conformant" yaml:"conformant"`
+	Message    string `json:"message,omitempty" yaml:"message"`
+}
+
+// Implementation describes the MCS implementation under test.
+type Implementation struct {
+	Organization string `json:"organization" yaml:"organization"`
+	Project      string `json:"project" yaml:"project"`
+	Version      string `json:"version" yaml:"version"`
+	URL          string `json:"url" yaml:"url"`
+}
+
+// Load reads a YAML or JSON report. Reports written before the schema was versioned are accepted.
+func Load(path string) (*Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Report{}
+	if err := yaml.Unmarshal(data, r); err != nil {
+		return nil, fmt.Errorf("error parsing report %s: %w", path, err)
+	}
+
+	switch r.SchemaVersion {
+	case SchemaVersion:
+	case "":
+		// Unversioned reports prefixed messages for the HTML report.
+		for i := range r.Groups {
+			for j := range r.Groups[i].Tests {
+				r.Groups[i].Tests[j].Message = strings.TrimPrefix(r.Groups[i].Tests[j].Message, " - ")
+			}
+		}
+	default:
+		return nil, fmt.Errorf("report %s has unsupported schema version %q", path, r.SchemaVersion)
+	}
+
+	return r, nil
+}
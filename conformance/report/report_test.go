@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func newReport(tests ...Test) *Report {
+	return &Report{
+		SchemaVersion: SchemaVersion,
+		Groups: []Group{
+			{Name: RequiredGroup, Tests: tests},
+			{Name: OptionalGroup, Tests: []Test{{Desc: "optional", Passed: true, Conformant: true}}},
+		},
+	}
+}
+
+func passed(desc string) Test {
+	return Test{Desc: desc, Passed: true, Conformant: true}
+}
+
+func nonConformant(desc string) Test {
+	return Test{Desc: desc, Message: "mismatch"}
+}
+
+func unknown(desc string) Test {
+	return Test{Desc: desc, Failed: true, Conformant: true, Message: "timeout"}
+}
+
+func skipped(desc string) Test {
+	return Test{Desc: desc, Skipped: true, Conformant: true}
+}
+
+func descs(changes []Change) []string {
+	var d []string
+	for _, change := range changes {
+		d = append(d, change.Desc)
+	}
+
+	return d
+}
+
+func TestCompare(t *testing.T) {
+	before := newReport(passed("kept"), passed("broken"), nonConformant("fixed"), unknown("flaky"), passed("now skipped"),
+		passed("removed"))
+	after := newReport(passed("kept"), nonConformant("broken"), passed("fixed"), nonConformant("flaky"),
+		skipped("now skipped"), unknown("added"))
+
+	diffs := Compare(before, after)
+	if len(diffs) != 2 {
+		t.Fatalf("expected a diff per group, got %v", diffs)
+	}
+
+	required := diffs[0]
+	if required.Group != RequiredGroup || !required.Regressed() {
+		t.Errorf("expected the Required group to regress, got %+v", required)
+	}
+
+	if got := descs(required.NewlyFailing); !slices.Equal(got, []string{"broken", "added"}) {
+		t.Errorf("unexpected newly failing tests %v", got)
+	}
+
+	if required.NewlyFailing[0].Before != StatusPassed || required.NewlyFailing[0].After != StatusNonConformant ||
+		required.NewlyFailing[1].Before != StatusMissing || required.NewlyFailing[1].After != StatusUnknown {
+		t.Errorf("unexpected statuses of newly failing tests %+v", required.NewlyFailing)
+	}
+
+	if got := descs(required.NewlyPassing); !slices.Equal(got, []string{"fixed"}) {
+		t.Errorf("unexpected newly passing tests %v", got)
+	}
+
+	if got := descs(required.NewlySkipped); !slices.Equal(got, []string{"now skipped"}) {
+		t.Errorf("unexpected newly skipped tests %v", got)
+	}
+
+	if optional := diffs[1]; optional.Group != OptionalGroup || !optional.Empty() {
+		t.Errorf("expected no change in the Optional group, got %+v", optional)
+	}
+}
+
+func TestRegressedProfiles(t *testing.T) {
+	before := &Report{Profiles: []Profile{
+		{Name: "Core", Claimed: true, Passed: true},
+		{Name: "DNS", Claimed: true, Passed: true},
+		{Name: "Headless", Claimed: true, Passed: false},
+		{Name: "DualStack", Passed: true},
+	}}
+	after := &Report{Profiles: []Profile{
+		{Name: "Core", Claimed: true, Passed: true},
+		{Name: "DNS", Claimed: true, Passed: false},
+		{Name: "Headless", Claimed: true, Passed: false},
+		{Name: "DualStack", Passed: false},
+	}}
+
+	if got := RegressedProfiles(before, after); !slices.Equal(got, []string{"DNS"}) {
+		t.Errorf("unexpected regressed profiles %v", got)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		return path
+	}
+
+	r, err := Load(write("report.json", `{"schemaVersion": "v1", "groups": [{"name": "Required", "tests": `+
+		`[{"desc": "test", "ref": "https://example.com", "passed": true, "conformant": true}]}], "total": 1, "passed": 1}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(r.Groups) != 1 || r.Groups[0].Tests[0].Status() != StatusPassed || r.Groups[0].Tests[0].Ref != "https://example.com" {
+		t.Errorf("unexpected report %+v", r)
+	}
+
+	r, err = Load(write("unversioned.yaml", `
+groups:
+  - name: Required
+    tests:
+      - desc: test
+        failed: true
+        conformant: true
+        message: ' - timeout'
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if test := r.Groups[0].Tests[0]; test.Status() != StatusUnknown || test.Message != "timeout" {
+		t.Errorf("unexpected test %+v", test)
+	}
+
+	if _, err := Load(write("v2.yaml", "schemaVersion: v2\n")); err == nil {
+		t.Error("expected an error loading an unsupported schema version")
+	}
+}
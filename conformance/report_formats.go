@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	"sigs.k8s.io/mcs-api/conformance/report"
+)
+
+type reportWriter struct {
+	fileName string
+	write    func(io.Writer, *report.Report) error
+}
+
+// reportWriters maps the formats accepted by --report-formats to their writers.
+var reportWriters = map[string]reportWriter{
+	"html":  {fileName: "report.html", write: writeHTMLReport},
+	"yaml":  {fileName: "report.yaml", write: writeYAMLReport},
+	"json":  {fileName: "report.json", write: writeJSONReport},
+	"junit": {fileName: "junit.xml", write: report.WriteJUnit},
+}
+
+// reportWritersFor returns the writers of the comma-separated formats.
+func reportWritersFor(formats string) ([]reportWriter, error) {
+	var writers []reportWriter
+
+	for _, format := range strings.Split(formats, ",") {
+		format = strings.TrimSpace(format)
+		if format == "" {
+			continue
+		}
+
+		writer, ok := reportWriters[format]
+		if !ok {
+			return nil, fmt.Errorf("unknown report format %q", format)
+		}
+
+		writers = append(writers, writer)
+	}
+
+	return writers, nil
+}
+
+// writeReports writes the report in each of the comma-separated formats to dir.
+func writeReports(dir, formats string, r *report.Report) error {
+	writers, err := reportWritersFor(formats)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, writer := range writers {
+		if err := writeReportFile(filepath.Join(dir, writer.fileName), writer.write, r); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func writeReportFile(path string, write func(io.Writer, *report.Report) error, r *report.Report) error {
+	out, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := write(out, r); err != nil {
+		_ = out.Close()
+		return fmt.Errorf("error writing %s: %w", path, err)
+	}
+
+	return out.Close()
+}
+
+func writeHTMLReport(out io.Writer, r *report.Report) error {
+	tmpl, err := template.New("report").Parse(reportHTML)
+	if err != nil {
+		return err
+	}
+
+	return tmpl.Execute(out, r)
+}
+
+func writeYAMLReport(out io.Writer, r *report.Report) error {
+	return yaml.NewEncoder(out).Encode(r)
+}
+
+func writeJSONReport(out io.Writer, r *report.Report) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(r)
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+)
+
+// readyConditionReasons are the documented reasons of the ServiceExport Ready condition for each status.
+var readyConditionReasons = map[metav1.ConditionStatus][]v1beta1.ServiceExportConditionReason{
+	metav1.ConditionTrue:    {v1beta1.ServiceExportReasonExported, v1beta1.ServiceExportReasonReady},
+	metav1.ConditionFalse:   {v1beta1.ServiceExportReasonPending, v1beta1.ServiceExportReasonFailed},
+	metav1.ConditionUnknown: {v1beta1.ServiceExportReasonPending},
+}
+
+var _ = Describe("", Label(OptionalLabel, ReadyConditionLabel), func() {
+	t := newTestDriver()
+
+	SpecifyWithSpecRef("The Ready condition of a ServiceExport should become True once the service is exported",
+		"https://github.com/kubernetes/enhancements/tree/master/keps/sig-multicluster/1645-multi-cluster-services-api#exporting-services",
+		func(ctx context.Context) {
+			t.awaitServiceExportReady(ctx, &clients[0], true)
+		})
+
+	SpecifyWithSpecRef("The Ready condition of a ServiceExport should no longer be True once the exported service is deleted "+
+		"and become True again once it's recreated",
+		"https://github.com/kubernetes/enhancements/tree/master/keps/sig-multicluster/1645-multi-cluster-services-api#exporting-services",
+		func(ctx context.Context) {
+			t.awaitServiceExportReady(ctx, &clients[0], true)
+
+			t.deleteHelloService(ctx, &clients[0])
+			t.awaitServiceExportReady(ctx, &clients[0], false)
+
+			t.recreateHelloService(ctx, &clients[0])
+			t.awaitServiceExportReady(ctx, &clients[0], true)
+		})
+
+	SpecifyWithSpecRef("The reason of the Ready condition of a ServiceExport should be one of the documented reasons for its status",
+		"https://github.com/kubernetes/enhancements/tree/master/keps/sig-multicluster/1645-multi-cluster-services-api#exporting-services",
+		func(ctx context.Context) {
+			expectDocumentedReadyReason(t.awaitServiceExportReady(ctx, &clients[0], true))
+
+			t.deleteHelloService(ctx, &clients[0])
+			expectDocumentedReadyReason(t.awaitServiceExportReady(ctx, &clients[0], false))
+		})
+})
+
+// awaitServiceExportReady waits for the Ready condition of the ServiceExport to be True, or to be anything other than
+// True if ready is false, and returns it.
+func (t *testDriver) awaitServiceExportReady(ctx context.Context, c *clusterClients, ready bool) metav1.Condition {
+	var condition metav1.Condition
+
+	By(fmt.Sprintf("Awaiting the ServiceExport Ready condition on cluster %q to be ready: %t", c.name, ready))
+
+	Eventually(func(g Gomega, ctx context.Context) {
+		se, err := c.mcs.MulticlusterV1beta1().ServiceExports(t.namespace).Get(ctx, helloServiceName, metav1.GetOptions{})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		cond := meta.FindStatusCondition(se.Status.Conditions, string(v1beta1.ServiceExportConditionReady))
+		g.Expect(cond).ToNot(BeNil(), reportNonConformant(
+			fmt.Sprintf("The ServiceExport on cluster %q has no %s condition", c.name, v1beta1.ServiceExportConditionReady)))
+
+		g.Expect(cond.Status == metav1.ConditionTrue).To(Equal(ready), reportNonConformant(
+			fmt.Sprintf("The %s condition of the ServiceExport on cluster %q has status %s", cond.Type, c.name, cond.Status)))
+
+		condition = *cond
+
+		// The final run succeeded so cancel any prior non-conformance reported.
+		cancelNonConformanceReport()
+	}).WithContext(ctx).Within(20 * time.Second).WithPolling(100 * time.Millisecond).Should(Succeed())
+
+	return condition
+}
+
+func expectDocumentedReadyReason(cond metav1.Condition) {
+	reasons := readyConditionReasons[cond.Status]
+
+	Expect(slices.Contains(reasons, v1beta1.ServiceExportConditionReason(cond.Reason))).To(BeTrue(), reportNonConformant(
+		fmt.Sprintf("The %s condition with status %s has reason %q, which isn't one of %v", cond.Type, cond.Status, cond.Reason, reasons)))
+}
+
+func (t *testDriver) deleteHelloService(ctx context.Context, c *clusterClients) {
+	Expect(c.k8s.CoreV1().Services(t.namespace).Delete(ctx, helloServiceName, metav1.DeleteOptions{})).To(Succeed())
+
+	By(fmt.Sprintf("Service \"%s/%s\" deleted on cluster %q", t.namespace, helloServiceName, c.name))
+}
+
+func (t *testDriver) recreateHelloService(ctx context.Context, c *clusterClients) {
+	_, err := c.k8s.CoreV1().Services(t.namespace).Create(ctx, newHelloService(), metav1.CreateOptions{})
+	Expect(err).ToNot(HaveOccurred())
+
+	By(fmt.Sprintf("Service \"%s/%s\" recreated on cluster %q", t.namespace, helloServiceName, c.name))
+}
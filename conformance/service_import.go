@@ -20,12 +20,15 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"slices"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
 )
@@ -36,6 +39,8 @@ var (
 	_ = Describe("", Label(HeadlessLabel), testHeadlessServiceImport)
 	_ = Describe("", Label(ExternalNameLabel), testExternalNameService)
 	_ = Describe("", testServiceTypeConflict)
+	_ = Describe("", testServiceImportClusters)
+	_ = Describe("", Label(OptionalLabel, ReadyConditionLabel), testServiceImportReadyCondition)
 )
 
 func testGeneralServiceImport() {
@@ -362,3 +367,121 @@ func testServiceTypeConflict() {
 			}
 		})
 }
+
+func testServiceImportClusters() {
+	t := newTestDriver()
+
+	SpecifyWithSpecRef("The status of a ServiceImport should list the exporting cluster",
+		"https://github.com/kubernetes/enhancements/tree/master/keps/sig-multicluster/1645-multi-cluster-services-api#importing-services",
+		Label(RequiredLabel), func(ctx context.Context) {
+			for i := range clients {
+				t.awaitServiceImportClusters(ctx, &clients[i], clients[0].clusterID)
+			}
+		})
+
+	Context("A service exported on two clusters", func() {
+		_ = newTwoClusterTestDriver(t)
+
+		SpecifyWithSpecRef("The status of a ServiceImport should list each exporting cluster and stop listing a cluster that unexports",
+			"https://github.com/kubernetes/enhancements/tree/master/keps/sig-multicluster/1645-multi-cluster-services-api#importing-services",
+			Label(RequiredLabel), func(ctx context.Context) {
+				for i := range clients {
+					t.awaitServiceImportClusters(ctx, &clients[i], clients[0].clusterID, clients[1].clusterID)
+				}
+
+				t.deleteServiceExport(ctx, &clients[1])
+
+				for i := range clients {
+					t.awaitServiceImportClusters(ctx, &clients[i], clients[0].clusterID)
+				}
+			})
+	})
+}
+
+func testServiceImportReadyCondition() {
+	t := newTestDriver()
+
+	SpecifyWithSpecRef("The Ready condition of a ClusterSetIP ServiceImport should become True once the service is imported",
+		"https://github.com/kubernetes/enhancements/tree/master/keps/sig-multicluster/1645-multi-cluster-services-api#importing-services",
+		Label(ClusterIPLabel), func(ctx context.Context) {
+			for i := range clients {
+				t.awaitServiceImportReadyCondition(ctx, &clients[i], func(g Gomega, cond *metav1.Condition) {
+					g.Expect(cond.Status).To(Equal(metav1.ConditionTrue), reportNonConformant(
+						fmt.Sprintf("The %s condition of the ServiceImport on cluster %q has status %s with reason %q",
+							cond.Type, clients[i].name, cond.Status, cond.Reason)))
+				})
+			}
+		})
+
+	Context("An IPv6 service exported into an IPv4 cluster", Label(DualStackLabel), func() {
+		var importingCluster *clusterClients
+
+		BeforeEach(func(ctx context.Context) {
+			requireTwoClusters()
+
+			if !slices.Contains(clusterIPFamilies(ctx, &clients[0]), corev1.IPv6Protocol) {
+				Skip(fmt.Sprintf("This test requires IPv6 support on cluster %q - skipping", clients[0].name))
+			}
+
+			importingCluster = nil
+
+			for i := 1; i < len(clients) && importingCluster == nil; i++ {
+				if slices.Equal(clusterIPFamilies(ctx, &clients[i]), []corev1.IPFamily{corev1.IPv4Protocol}) {
+					importingCluster = &clients[i]
+				}
+			}
+
+			if importingCluster == nil {
+				Skip("This test requires an IPv4-only cluster - skipping")
+			}
+
+			t.helloService.Spec.IPFamilyPolicy = ptr.To(corev1.IPFamilyPolicySingleStack)
+			t.helloService.Spec.IPFamilies = []corev1.IPFamily{corev1.IPv6Protocol}
+		})
+
+		SpecifyWithSpecRef("should report IPFamilyNotSupported in the Ready condition of the ServiceImport on the IPv4 cluster",
+			"https://github.com/kubernetes/enhancements/tree/master/keps/sig-multicluster/1645-multi-cluster-services-api#clustersetip",
+			func(ctx context.Context) {
+				Expect(t.awaitServiceImportIPFamilies(ctx, &clients[0])).To(Equal([]corev1.IPFamily{corev1.IPv6Protocol}),
+					reportNonConformant(fmt.Sprintf("The ServiceImport on cluster %q should only have the IPv6 family", clients[0].name)))
+
+				t.awaitServiceImportReadyCondition(ctx, importingCluster, func(g Gomega, cond *metav1.Condition) {
+					g.Expect(cond.Status).ToNot(Equal(metav1.ConditionTrue), reportNonConformant(
+						fmt.Sprintf("The %s condition of the ServiceImport on IPv4-only cluster %q is True", cond.Type, importingCluster.name)))
+
+					g.Expect(cond.Reason).To(Equal(string(v1beta1.ServiceImportReasonIPFamilyNotSupported)), reportNonConformant(
+						fmt.Sprintf("The %s condition of the ServiceImport on IPv4-only cluster %q has reason %q",
+							cond.Type, importingCluster.name, cond.Reason)))
+				})
+			})
+	})
+}
+
+// awaitServiceImportReadyCondition waits for the ServiceImport to have a Ready condition satisfying verify.
+func (t *testDriver) awaitServiceImportReadyCondition(ctx context.Context, c *clusterClients, verify func(Gomega, *metav1.Condition)) {
+	t.awaitServiceImport(ctx, c, helloServiceName, true, func(g Gomega, serviceImport *v1beta1.ServiceImport) {
+		cond := meta.FindStatusCondition(serviceImport.Status.Conditions, string(v1beta1.ServiceImportConditionReady))
+		g.Expect(cond).ToNot(BeNil(), reportNonConformant(
+			fmt.Sprintf("The ServiceImport on cluster %q has no %s condition", c.name, v1beta1.ServiceImportConditionReady)))
+
+		verify(g, cond)
+	})
+}
+
+func (t *testDriver) awaitServiceImportClusters(ctx context.Context, c *clusterClients, clusterIDs ...string) {
+	t.awaitServiceImport(ctx, c, helloServiceName, true, func(g Gomega, serviceImport *v1beta1.ServiceImport) {
+		var clusters []string
+
+		for _, status := range serviceImport.Status.Clusters {
+			g.Expect(validation.IsDNS1123Label(status.Cluster)).To(BeEmpty(), reportNonConformant(
+				fmt.Sprintf("The ServiceImport on cluster %q lists cluster %q which isn't a valid RFC-1123 DNS label",
+					c.name, status.Cluster)))
+
+			clusters = append(clusters, status.Cluster)
+		}
+
+		g.Expect(clusters).To(ConsistOf(clusterIDs), reportNonConformant(
+			fmt.Sprintf("The clusters listed in the status of the ServiceImport on cluster %q don't match the exporting clusters %v",
+				c.name, clusterIDs)))
+	})
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+)
+
+// CacheByObject returns the cache configuration that limits the memory used by
+// the controllers on large clusters: only the EndpointSlices of multi-cluster
+// services are cached, and Services that aren't derived from a ServiceImport
+// are cached without anything but their identity, owners and type, which is
+// all the ServiceExport controller and webhook need. All Services are cached
+// rather than only the exported ones: the ServiceExport controller and webhook
+// read the Service named by any ServiceExport, which can be created before or
+// after it, so a label or field selector can't tell which Services they need.
+// Managers running the controllers through SetupWithManager should use it in
+// their cache options.
+func CacheByObject() map[client.Object]cache.ByObject {
+	hasServiceName, err := labels.NewRequirement(v1beta1.LabelServiceName, selection.Exists, nil)
+	utilruntime.Must(err)
+	return map[client.Object]cache.ByObject{
+		&discoveryv1.EndpointSlice{}: {Label: labels.NewSelector().Add(*hasServiceName)},
+		&v1.Service{}:                {Transform: stripService},
+	}
+}
+
+// stripService reduces a Service that isn't derived from a ServiceImport to
+// the fields read by the controllers. Stripped Services are never updated, the
+// ServiceImport controller only updates Services it owns.
+func stripService(obj any) (any, error) {
+	svc, ok := obj.(*v1.Service)
+	if !ok || serviceImportOwner(svc.OwnerReferences) != "" {
+		return obj, nil
+	}
+	return &v1.Service{
+		TypeMeta: svc.TypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         svc.Namespace,
+			Name:              svc.Name,
+			UID:               svc.UID,
+			ResourceVersion:   svc.ResourceVersion,
+			Generation:        svc.Generation,
+			CreationTimestamp: svc.CreationTimestamp,
+			DeletionTimestamp: svc.DeletionTimestamp,
+			OwnerReferences:   svc.OwnerReferences,
+		},
+		Spec: v1.ServiceSpec{Type: svc.Spec.Type},
+	}, nil
+}
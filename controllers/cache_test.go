@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+)
+
+var _ = Describe("Cache", func() {
+	byObject := CacheByObject()
+
+	It("only selects the EndpointSlices of multi-cluster services", func() {
+		var selector labels.Selector
+		for obj, config := range byObject {
+			if _, ok := obj.(*discoveryv1.EndpointSlice); ok {
+				selector = config.Label
+			}
+		}
+		Expect(selector).ToNot(BeNil())
+		Expect(selector.Matches(labels.Set{v1beta1.LabelServiceName: "svc"})).To(BeTrue())
+		Expect(selector.Matches(labels.Set{discoveryv1.LabelServiceName: "svc"})).To(BeFalse())
+	})
+
+	Context("stripService", func() {
+		svc := func(owners ...metav1.OwnerReference) *v1.Service {
+			return &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:       "ns",
+					Name:            "svc",
+					UID:             "uid",
+					Labels:          map[string]string{"app": "svc"},
+					Annotations:     map[string]string{"large": "annotation"},
+					OwnerReferences: owners,
+				},
+				Spec: v1.ServiceSpec{
+					Type:     v1.ServiceTypeExternalName,
+					Ports:    []v1.ServicePort{{Port: 80}},
+					Selector: map[string]string{"app": "svc"},
+				},
+			}
+		}
+
+		It("keeps derived Services whole", func() {
+			derived := svc(metav1.OwnerReference{
+				APIVersion: v1beta1.GroupVersion.String(),
+				Kind:       serviceImportKind,
+				Name:       "svc",
+			})
+			Expect(stripService(derived.DeepCopy())).To(Equal(derived))
+		})
+
+		It("keeps the identity, owners and type of other Services", func() {
+			stripped, err := stripService(svc(metav1.OwnerReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "app"}))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stripped).To(Equal(&v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:       "ns",
+					Name:            "svc",
+					UID:             "uid",
+					OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "app"}},
+				},
+				Spec: v1.ServiceSpec{Type: v1.ServiceTypeExternalName},
+			}))
+		})
+	})
+})
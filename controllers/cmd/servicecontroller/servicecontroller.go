@@ -19,47 +19,64 @@ package main
 import (
 	"flag"
 	"os"
+	"strings"
 
-	"k8s.io/apimachinery/pkg/runtime"
-	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
-	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
-	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/mcs-api/controllers"
-	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
 )
 
-var (
-	scheme   = runtime.NewScheme()
-	setupLog = ctrl.Log.WithName("setup")
-)
-
-func init() {
-	clientgoscheme.AddToScheme(scheme)
-	v1beta1.AddToScheme(scheme)
-}
+var setupLog = ctrl.Log.WithName("setup")
 
 func main() {
-	var metricsAddr string
-	var enableLeaderElection bool
-	flag.StringVar(&metricsAddr, "metrics-addr", ":8080", "The address the metric endpoint binds to.")
-	flag.BoolVar(&enableLeaderElection, "enable-leader-election", false,
+	var opts controllers.Options
+	var namespaces string
+	var naming string
+	flag.StringVar(&opts.MetricsAddr, "metrics-addr", ":8080", "The address the metric endpoint binds to.")
+	flag.StringVar(&opts.HealthProbeAddr, "health-probe-addr", ":8081", "The address the health and readiness probes bind to.")
+	flag.BoolVar(&opts.LeaderElection, "enable-leader-election", false,
 		"Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
+	flag.StringVar(&opts.LeaderElectionID, "leader-election-id", controllers.DefaultLeaderElectionID,
+		"The name of the lease used for leader election.")
+	flag.StringVar(&opts.LeaderElectionNamespace, "leader-election-namespace", "",
+		"The namespace of the lease used for leader election, defaulting to the namespace the controller runs in.")
+	flag.StringVar(&namespaces, "namespaces", "",
+		"A comma-separated list of the namespaces to watch. All namespaces are watched if empty.")
+	flag.IntVar(&opts.ServiceImportConcurrency, "serviceimport-concurrency", 1,
+		"The maximum number of ServiceImports reconciled concurrently.")
+	flag.IntVar(&opts.ServiceConcurrency, "service-concurrency", 1,
+		"The maximum number of Services reconciled concurrently.")
+	flag.IntVar(&opts.ServiceExportConcurrency, "serviceexport-concurrency", 1,
+		"The maximum number of ServiceExports reconciled concurrently.")
+	flag.IntVar(&opts.EndpointSliceConcurrency, "endpointslice-concurrency", 1,
+		"The maximum number of EndpointSlices reconciled concurrently.")
+	flag.BoolVar(&opts.EnableWebhooks, "enable-webhooks", false,
+		"Enable the webhook server, serving the CRD conversion and ServiceExport validating webhooks. The CRDs must be "+
+			"patched with config/webhook/crd-conversion-patch.yaml to use the conversion webhook.")
+	flag.IntVar(&opts.WebhookPort, "webhook-port", 9443, "The port the webhook server listens on.")
+	flag.DurationVar(&opts.Sweeper.Interval, "orphan-sweep-interval", 0,
+		"The interval between sweeps for derived Services and EndpointSlices left behind by deleted ServiceImports. Zero, the default, disables sweeping.")
+	flag.BoolVar(&opts.Sweeper.DryRun, "orphan-sweep-dry-run", false,
+		"Only report orphaned objects found by sweeps, without deleting them.")
+	flag.StringVar(&opts.Sweeper.EndpointSliceManagedBy, "orphan-sweep-endpointslice-managed-by", "",
+		"The managed-by label of the EndpointSlices imported by the MCS implementation. Only EndpointSlices with this "+
+			"label are swept, none if it is empty.")
+	flag.StringVar(&naming, "derived-service-naming", "hash",
+		"The naming strategy of derived Services, either \"hash\" for derived-<hash> or \"suffix\" for <name>-mcs. "+
+			"Derived Services named by another strategy are renamed by the orphan sweeper.")
 	flag.Parse()
-	opts := ctrl.Options{
-		Scheme: scheme,
-		Metrics: server.Options{
-			BindAddress: metricsAddr,
-		},
-		LeaderElection: enableLeaderElection,
-		WebhookServer: webhook.NewServer(webhook.Options{
-			Port: 9443,
-		}),
-	}
 	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
 
+	if namespaces != "" {
+		opts.Namespaces = strings.Split(namespaces, ",")
+	}
+	var found bool
+	if opts.Naming, found = controllers.NamingStrategies[naming]; !found {
+		setupLog.Error(nil, "unknown derived Service naming strategy", "naming", naming)
+		os.Exit(1)
+	}
+
 	if err := controllers.Start(ctrl.SetupSignalHandler(), ctrl.GetConfigOrDie(), setupLog, opts); err != nil {
 		setupLog.Error(err, "problem running controllers")
 		os.Exit(1)
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"os"
+
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/mcs-api/controllers/migration"
+	mcsclient "sigs.k8s.io/mcs-api/pkg/client/clientset/versioned"
+)
+
+var setupLog = ctrl.Log.WithName("setup")
+
+func main() {
+	var pageSize int64
+	flag.Int64Var(&pageSize, "page-size", migration.DefaultPageSize, "The number of objects to list per request.")
+	flag.Parse()
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	cfg := ctrl.GetConfigOrDie()
+	client, err := mcsclient.NewForConfig(cfg)
+	if err != nil {
+		setupLog.Error(err, "unable to create MCS client")
+		os.Exit(1)
+	}
+	crdClient, err := apiextensionsclient.NewForConfig(cfg)
+	if err != nil {
+		setupLog.Error(err, "unable to create CRD client")
+		os.Exit(1)
+	}
+
+	m := &migration.Migrator{
+		Client:    client,
+		CRDClient: crdClient,
+		Log:       ctrl.Log.WithName("migration"),
+		PageSize:  pageSize,
+	}
+	if err := m.Run(ctrl.SetupSignalHandler()); err != nil {
+		setupLog.Error(err, "problem running migration")
+		os.Exit(1)
+	}
+}
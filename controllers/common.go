@@ -18,15 +18,16 @@ package controllers
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/base32"
-	"os"
-	"strings"
+	"fmt"
 
 	"github.com/go-logr/logr"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
 )
 
 const (
@@ -36,46 +37,100 @@ const (
 	serviceImportKind        = "ServiceImport"
 )
 
-func derivedName(name types.NamespacedName) string {
-	hash := sha256.New()
-	hash.Write([]byte(name.String()))
-	return "derived-" + strings.ToLower(base32.HexEncoding.WithPadding(base32.NoPadding).EncodeToString(hash.Sum(nil)))[:10]
+// Start the controllers with the supplied config in a new manager configured
+// by opts, and run them until the context is done. Errors are returned rather
+// than logged, setupLog only reports the manager starting.
+func Start(ctx context.Context, cfg *rest.Config, setupLog logr.Logger, opts Options) error {
+	mgr, err := ctrl.NewManager(cfg, opts.ManagerOptions())
+	if err != nil {
+		return fmt.Errorf("unable to create manager: %w", err)
+	}
+	if err := SetupWithManager(mgr, opts); err != nil {
+		return err
+	}
+
+	setupLog.Info("starting manager")
+	if err := mgr.Start(ctx); err != nil {
+		return fmt.Errorf("problem running manager: %w", err)
+	}
+	return nil
 }
 
-// Start the controllers with the supplied config
-func Start(ctx context.Context, cfg *rest.Config, setupLog logr.Logger, opts ctrl.Options) error {
-	mgr, err := ctrl.NewManager(cfg, opts)
-	if err != nil {
-		setupLog.Error(err, "unable to start manager")
-		os.Exit(1)
+// SetupWithManager adds the controllers, the orphan sweeper, the metrics and
+// the health checks to an existing manager. The conversion and ServiceExport
+// validating webhooks are registered if opts.EnableWebhooks is set. The
+// manager's scheme must include the client-go, v1alpha1 and v1beta1 types, and
+// its cache should be configured with CacheByObject.
+func SetupWithManager(mgr ctrl.Manager, opts Options) error {
+	naming := opts.Naming
+	if naming == nil {
+		naming = HashNamingStrategy{}
 	}
 
-	if err = (&ServiceImportReconciler{
-		Client: mgr.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("ServiceImport"),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "ServiceImport")
-		return err
+	if err := (&ServiceImportReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("ServiceImport"),
+		Recorder: mgr.GetEventRecorderFor("mcs-serviceimport-controller"),
+		Naming:   naming,
+	}).SetupWithManager(mgr, controller.Options{MaxConcurrentReconciles: opts.ServiceImportConcurrency}); err != nil {
+		return fmt.Errorf("unable to create ServiceImport controller: %w", err)
 	}
-	if err = (&ServiceReconciler{
-		Client: mgr.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("Service"),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Service")
-		return err
+	if err := (&ServiceReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Service"),
+		Recorder: mgr.GetEventRecorderFor("mcs-service-controller"),
+	}).SetupWithManager(mgr, controller.Options{MaxConcurrentReconciles: opts.ServiceConcurrency}); err != nil {
+		return fmt.Errorf("unable to create Service controller: %w", err)
 	}
-	if err = (&EndpointSliceReconciler{
+	if err := (&ServiceExportReconciler{
 		Client: mgr.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("EndpointSlice"),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "EndpointSlice")
-		return err
+		Log:    ctrl.Log.WithName("controllers").WithName("ServiceExport"),
+	}).SetupWithManager(mgr, controller.Options{MaxConcurrentReconciles: opts.ServiceExportConcurrency}); err != nil {
+		return fmt.Errorf("unable to create ServiceExport controller: %w", err)
+	}
+	if err := (&EndpointSliceReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("EndpointSlice"),
+		Recorder: mgr.GetEventRecorderFor("mcs-endpointslice-controller"),
+	}).SetupWithManager(mgr, controller.Options{MaxConcurrentReconciles: opts.EndpointSliceConcurrency}); err != nil {
+		return fmt.Errorf("unable to create EndpointSlice controller: %w", err)
 	}
 
-	setupLog.Info("starting manager")
-	if err := mgr.Start(ctx); err != nil {
-		setupLog.Error(err, "problem running manager")
-		return err
+	if err := registerStateCollector(metrics.Registry, mgr.GetCache(), ctrl.Log.WithName("metrics")); err != nil {
+		return fmt.Errorf("unable to register metrics: %w", err)
+	}
+
+	if opts.Sweeper.Interval > 0 {
+		if err := mgr.Add(&OrphanSweeper{
+			Client:         mgr.GetClient(),
+			Log:            ctrl.Log.WithName("sweeper"),
+			Recorder:       mgr.GetEventRecorderFor("mcs-orphan-sweeper"),
+			Naming:         naming,
+			SweeperOptions: opts.Sweeper,
+		}); err != nil {
+			return fmt.Errorf("unable to add orphan sweeper: %w", err)
+		}
+	}
+
+	readyCheck := healthz.Ping
+	if opts.EnableWebhooks {
+		mgr.GetWebhookServer().Register(ConversionWebhookPath, &ConversionWebhook{
+			Scheme: mgr.GetScheme(),
+			Log:    ctrl.Log.WithName("webhooks").WithName("Conversion"),
+		})
+		mgr.GetWebhookServer().Register(ServiceExportValidationWebhookPath, admission.WithCustomValidator(
+			mgr.GetScheme(), &v1beta1.ServiceExport{}, &ServiceExportValidator{
+				Client: mgr.GetClient(),
+				Log:    ctrl.Log.WithName("webhooks").WithName("ServiceExport"),
+			}))
+		readyCheck = mgr.GetWebhookServer().StartedChecker()
+	}
+
+	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
+		return fmt.Errorf("unable to add health check: %w", err)
+	}
+	if err := mgr.AddReadyzCheck("readyz", readyCheck); err != nil {
+		return fmt.Errorf("unable to add readiness check: %w", err)
 	}
 	return nil
 }
@@ -19,7 +19,6 @@ package controllers
 import (
 	"context"
 	"fmt"
-	"math/rand"
 	"path/filepath"
 	"testing"
 	"time"
@@ -28,81 +27,101 @@ import (
 	. "github.com/onsi/gomega"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
-	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
-	"k8s.io/client-go/tools/clientcmd"
-	ctrl "sigs.k8s.io/controller-runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
-	"sigs.k8s.io/kind/pkg/cluster"
+	"sigs.k8s.io/mcs-api/controllers/internal/testenv"
 	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
 )
 
-const (
-	clusterName = "test-cluster"
-)
+// envtestLabel marks the specs that run the controllers against an envtest
+// control plane.
+const envtestLabel = testenv.Label
 
 var (
-	cfg             *rest.Config
-	k8s             client.Client
-	env             *envtest.Environment
-	clusterProvider *cluster.Provider
-	testNS          string
+	cfg    *rest.Config
+	k8s    client.Client
+	env    *envtest.Environment
+	testNS string
+	cancel context.CancelFunc
 )
 
-var _ = BeforeSuite(func(done Done) {
-	rand.Seed(GinkgoRandomSeed())
+var _ = BeforeSuite(func() {
 	log.SetLogger(zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter)))
-	// Use Kind for a more up-to-date K8s
-	clusterProvider = cluster.NewProvider()
-	Expect(clusterProvider.Create(clusterName)).To(Succeed())
-	kubeconfig, err := clusterProvider.KubeConfig(clusterName, false)
-	Expect(err).ToNot(HaveOccurred())
-
-	cfg, err := clientcmd.RESTConfigFromKubeConfig([]byte(kubeconfig))
-	Expect(err).ToNot(HaveOccurred())
-	scheme := runtime.NewScheme()
-	Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
-	Expect(v1beta1.AddToScheme(scheme)).To(Succeed())
-	Expect(err).ToNot(HaveOccurred())
-	existingCluster := true
-	env = &envtest.Environment{
-		CRDDirectoryPaths:  []string{filepath.Join("..", "..", "config", "crd")},
-		UseExistingCluster: &existingCluster,
-		Config:             cfg,
+	testNS = fmt.Sprintf("test-%v", time.Now().Unix())
+	if env, cfg = testenv.Start(filepath.Join("..", "config", "crd")); env == nil {
+		return
 	}
-	cfg, err = env.Start()
-	Expect(err).ToNot(HaveOccurred())
-	Expect(cfg).ToNot(BeNil())
 
+	scheme := NewScheme()
+	var err error
 	k8s, err = client.New(cfg, client.Options{Scheme: scheme})
 	Expect(err).ToNot(HaveOccurred())
 	Expect(k8s).ToNot(BeNil())
 
-	testNS = fmt.Sprintf("test-%v", time.Now().Unix())
 	Expect(k8s.Create(context.Background(), &v1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: testNS,
 		},
 	})).To(Succeed())
 
-	opts := ctrl.Options{
-		Scheme: scheme,
-	}
+	var ctx context.Context
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		defer GinkgoRecover()
+		Expect(Start(ctx, cfg, log.Log, Options{
+			Scheme:      scheme,
+			MetricsAddr: "0",
+			// envtest doesn't run the garbage collector, the sweeper deletes
+			// the derived Services of deleted ServiceImports instead.
+			Sweeper: SweeperOptions{Interval: time.Second, GracePeriod: ptr.To(time.Duration(0))},
+		})).To(Succeed())
+	}()
+})
 
-	go Start(context.TODO(), cfg, log.Log, opts)
-	close(done)
+var _ = BeforeEach(func() {
+	testenv.SkipIfNotStarted(env)
 })
 
+// newFakeClient returns a fake client for unit tests of the reconcilers.
+func newFakeClient(funcs interceptor.Funcs, objs ...client.Object) client.WithWatch {
+	return fake.NewClientBuilder().WithScheme(NewScheme()).
+		WithObjects(objs...).
+		WithStatusSubresource(&v1beta1.ServiceImport{}, &v1beta1.ServiceExport{}, &v1.Service{}).
+		WithInterceptorFuncs(funcs).
+		Build()
+}
+
+// allocateClusterIPs simulates the allocation of IPv4 cluster IPs to the
+// Services created through a fake client, as done by the API server.
+func allocateClusterIPs() interceptor.Funcs {
+	allocated := 0
+	return interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if svc, ok := obj.(*v1.Service); ok && svc.Spec.ClusterIP == "" {
+				allocated++
+				svc.Spec.ClusterIP = fmt.Sprintf("10.96.0.%d", allocated)
+				svc.Spec.ClusterIPs = []string{svc.Spec.ClusterIP}
+			}
+			return c.Create(ctx, obj, opts...)
+		},
+	}
+}
+
 var _ = AfterSuite(func() {
-	Expect(clusterProvider.Delete(clusterName, "")).To(Succeed())
-	err := env.Stop()
-	Expect(err).ToNot(HaveOccurred())
+	if env == nil {
+		return
+	}
+	cancel()
+	Expect(env.Stop()).To(Succeed())
 })
 
 func TestControllers(t *testing.T) {
 	RegisterFailHandler(Fail)
+	RunSpecs(t, "Controllers Suite")
 }
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ConversionWebhookPath is the path on which the CRD conversion webhook is served.
+const ConversionWebhookPath = "/convert"
+
+// ConversionWebhook converts ServiceExport and ServiceImport objects between
+// the served API versions using the conversion functions registered in Scheme.
+type ConversionWebhook struct {
+	Scheme *runtime.Scheme
+	Log    logr.Logger
+}
+
+// ServeHTTP handles a ConversionReview.
+func (wh *ConversionWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var review apiextensionsv1.ConversionReview
+	if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+		wh.Log.Error(err, "unable to decode conversion review")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if review.Request == nil {
+		http.Error(w, "conversion review contains no request", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = wh.convertObjects(review.Request)
+	review.Request = nil
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(&review); err != nil {
+		wh.Log.Error(err, "unable to encode conversion review")
+	}
+}
+
+func (wh *ConversionWebhook) convertObjects(req *apiextensionsv1.ConversionRequest) *apiextensionsv1.ConversionResponse {
+	resp := &apiextensionsv1.ConversionResponse{UID: req.UID}
+	toGV, err := schema.ParseGroupVersion(req.DesiredAPIVersion)
+	if err != nil {
+		resp.Result = conversionFailure(err)
+		return resp
+	}
+	for _, obj := range req.Objects {
+		converted, err := wh.convert(obj.Raw, toGV)
+		if err != nil {
+			wh.Log.Error(err, "unable to convert object", "desiredAPIVersion", req.DesiredAPIVersion)
+			resp.ConvertedObjects = nil
+			resp.Result = conversionFailure(err)
+			return resp
+		}
+		resp.ConvertedObjects = append(resp.ConvertedObjects, runtime.RawExtension{Raw: converted})
+	}
+	resp.Result = metav1.Status{Status: metav1.StatusSuccess}
+	return resp
+}
+
+func (wh *ConversionWebhook) convert(raw []byte, toGV schema.GroupVersion) ([]byte, error) {
+	var typeMeta metav1.TypeMeta
+	if err := json.Unmarshal(raw, &typeMeta); err != nil {
+		return nil, err
+	}
+	fromGVK := typeMeta.GroupVersionKind()
+	if fromGVK.GroupVersion() == toGV {
+		return raw, nil
+	}
+	if fromGVK.Group != toGV.Group {
+		return nil, fmt.Errorf("cannot convert %s to group %q", fromGVK, toGV.Group)
+	}
+
+	src, err := wh.Scheme.New(fromGVK)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, src); err != nil {
+		return nil, err
+	}
+	toGVK := toGV.WithKind(fromGVK.Kind)
+	dst, err := wh.Scheme.New(toGVK)
+	if err != nil {
+		return nil, err
+	}
+	if err := wh.Scheme.Convert(src, dst, nil); err != nil {
+		return nil, err
+	}
+	dst.GetObjectKind().SetGroupVersionKind(toGVK)
+	return json.Marshal(dst)
+}
+
+func conversionFailure(err error) metav1.Status {
+	return metav1.Status{
+		Status:  metav1.StatusFailure,
+		Message: err.Error(),
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+)
+
+var _ = Describe("ConversionWebhook", func() {
+	var webhook *ConversionWebhook
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(v1beta1.AddToScheme(scheme)).To(Succeed())
+		webhook = &ConversionWebhook{Scheme: scheme, Log: logr.Discard()}
+	})
+
+	review := func(desiredAPIVersion string, objs ...runtime.Object) *apiextensionsv1.ConversionResponse {
+		req := &apiextensionsv1.ConversionRequest{UID: "uid", DesiredAPIVersion: desiredAPIVersion}
+		for _, obj := range objs {
+			raw, err := json.Marshal(obj)
+			Expect(err).ToNot(HaveOccurred())
+			req.Objects = append(req.Objects, runtime.RawExtension{Raw: raw})
+		}
+		body, err := json.Marshal(&apiextensionsv1.ConversionReview{
+			TypeMeta: metav1.TypeMeta{APIVersion: apiextensionsv1.SchemeGroupVersion.String(), Kind: "ConversionReview"},
+			Request:  req,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		rec := httptest.NewRecorder()
+		webhook.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, ConversionWebhookPath, bytes.NewReader(body)))
+		Expect(rec.Code).To(Equal(http.StatusOK))
+
+		var resp apiextensionsv1.ConversionReview
+		Expect(json.Unmarshal(rec.Body.Bytes(), &resp)).To(Succeed())
+		Expect(resp.Response).ToNot(BeNil())
+		Expect(resp.Response.UID).To(BeEquivalentTo("uid"))
+		return resp.Response
+	}
+
+	It("converts a v1alpha1 ServiceImport to v1beta1", func() {
+		resp := review(v1beta1.GroupVersion.String(), &v1alpha1.ServiceImport{
+			TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.GroupVersion.String(), Kind: v1alpha1.ServiceImportKindName},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc"},
+			Spec: v1alpha1.ServiceImportSpec{
+				Type:            v1alpha1.ClusterSetIP,
+				IPs:             []string{"10.42.42.42"},
+				IPFamilies:      []v1.IPFamily{v1.IPv4Protocol},
+				SessionAffinity: v1.ServiceAffinityClientIP,
+				Ports:           []v1alpha1.ServicePort{{Name: "http", Protocol: v1.ProtocolTCP, Port: 80}},
+			},
+			Status: v1alpha1.ServiceImportStatus{
+				Clusters: []v1alpha1.ClusterStatus{{Cluster: "c1"}},
+			},
+		})
+		Expect(resp.Result.Status).To(Equal(metav1.StatusSuccess))
+		Expect(resp.ConvertedObjects).To(HaveLen(1))
+
+		var si v1beta1.ServiceImport
+		Expect(json.Unmarshal(resp.ConvertedObjects[0].Raw, &si)).To(Succeed())
+		Expect(si.APIVersion).To(Equal(v1beta1.GroupVersion.String()))
+		Expect(si.Kind).To(Equal(v1beta1.ServiceImportKindName))
+		Expect(si.Name).To(Equal("svc"))
+		Expect(si.Spec.Type).To(Equal(v1beta1.ClusterSetIP))
+		Expect(si.Spec.IPs).To(Equal([]string{"10.42.42.42"}))
+		Expect(si.Spec.IPFamilies).To(Equal([]v1.IPFamily{v1.IPv4Protocol}))
+		Expect(si.Spec.SessionAffinity).To(Equal(v1.ServiceAffinityClientIP))
+		Expect(si.Spec.Ports).To(Equal([]v1beta1.ServicePort{{Name: "http", Protocol: v1.ProtocolTCP, Port: 80}}))
+		Expect(si.Status.Clusters).To(Equal([]v1beta1.ClusterStatus{{Cluster: "c1"}}))
+	})
+
+	It("converts a v1beta1 ServiceExport to v1alpha1", func() {
+		resp := review(v1alpha1.GroupVersion.String(), &v1beta1.ServiceExport{
+			TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.GroupVersion.String(), Kind: v1beta1.ServiceExportKindName},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc"},
+			Spec: v1beta1.ServiceExportSpec{
+				ExportedLabels: map[string]string{"label": "value"},
+			},
+			Status: v1beta1.ServiceExportStatus{
+				Conditions: []metav1.Condition{{
+					Type:   string(v1beta1.ServiceExportConditionValid),
+					Status: metav1.ConditionTrue,
+					Reason: string(v1beta1.ServiceExportReasonValid),
+				}},
+			},
+		})
+		Expect(resp.Result.Status).To(Equal(metav1.StatusSuccess))
+		Expect(resp.ConvertedObjects).To(HaveLen(1))
+
+		var se v1alpha1.ServiceExport
+		Expect(json.Unmarshal(resp.ConvertedObjects[0].Raw, &se)).To(Succeed())
+		Expect(se.APIVersion).To(Equal(v1alpha1.GroupVersion.String()))
+		Expect(se.Spec.ExportedLabels).To(HaveKeyWithValue("label", "value"))
+		Expect(se.Status.Conditions).To(HaveLen(1))
+		Expect(se.Status.Conditions[0].Type).To(Equal(v1alpha1.ServiceExportValid))
+	})
+
+	It("fails on an unknown API version", func() {
+		resp := review(v1beta1.GroupName+"/v1", &v1beta1.ServiceExport{
+			TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.GroupVersion.String(), Kind: v1beta1.ServiceExportKindName},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc"},
+		})
+		Expect(resp.Result.Status).To(Equal(metav1.StatusFailure))
+		Expect(resp.ConvertedObjects).To(BeEmpty())
+	})
+})
@@ -20,17 +20,28 @@ import (
 	"context"
 
 	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
 )
 
+const (
+	eventReasonRelabelled    = "Relabelled"
+	eventReasonRelabelFailed = "RelabelFailed"
+)
+
 // EndpointSliceReconciler reconciles a EndpointSlice object
 type EndpointSliceReconciler struct {
 	client.Client
-	Log logr.Logger
+	Log      logr.Logger
+	Recorder record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch;update;patch
@@ -57,20 +68,50 @@ func (r *EndpointSliceReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, nil
 	}
 	// Ensure the EndpointSlice is labelled to match the ServiceImport's derived
-	// Service.
-	serviceName := derivedName(types.NamespacedName{Namespace: epSlice.Namespace, Name: epSlice.Labels[v1beta1.LabelServiceName]})
-	if epSlice.Labels[discoveryv1.LabelServiceName] == serviceName {
+	// Service. The name is taken from the ServiceImport so that the slices
+	// follow its derived Service when the naming strategy changes; the
+	// EndpointSlice is reconciled again once the ServiceImport is annotated.
+	var svcImport v1beta1.ServiceImport
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: epSlice.Namespace, Name: epSlice.Labels[v1beta1.LabelServiceName]}, &svcImport); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	serviceName := svcImport.Annotations[DerivedServiceAnnotation]
+	if serviceName == "" || epSlice.Labels[discoveryv1.LabelServiceName] == serviceName {
 		return ctrl.Result{}, nil
 	}
 	epSlice.Labels[discoveryv1.LabelServiceName] = serviceName
 	if err := r.Client.Update(ctx, &epSlice); err != nil {
+		r.Recorder.Eventf(&epSlice, v1.EventTypeWarning, eventReasonRelabelFailed,
+			"Failed to set label %s=%s: %v", discoveryv1.LabelServiceName, serviceName, err)
 		return ctrl.Result{}, err
 	}
 	log.Info("added label", discoveryv1.LabelServiceName, serviceName)
+	endpointSlicesRelabelled.Inc()
+	r.Recorder.Eventf(&epSlice, v1.EventTypeNormal, eventReasonRelabelled,
+		"Set label %s=%s", discoveryv1.LabelServiceName, serviceName)
 	return ctrl.Result{}, nil
 }
 
 // SetupWithManager wires up the controller.
-func (r *EndpointSliceReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).For(&discoveryv1.EndpointSlice{}).Complete(r)
+func (r *EndpointSliceReconciler) SetupWithManager(mgr ctrl.Manager, opts controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).For(&discoveryv1.EndpointSlice{}).
+		Watches(&v1beta1.ServiceImport{}, handler.EnqueueRequestsFromMapFunc(r.serviceImportToEndpointSlices)).
+		WithOptions(opts).
+		Complete(countErrors("endpointslice", r))
+}
+
+// serviceImportToEndpointSlices maps a ServiceImport to the EndpointSlices of
+// its service.
+func (r *EndpointSliceReconciler) serviceImportToEndpointSlices(ctx context.Context, obj client.Object) []reconcile.Request {
+	var slices discoveryv1.EndpointSliceList
+	if err := r.Client.List(ctx, &slices, client.InNamespace(obj.GetNamespace()),
+		client.MatchingLabels{v1beta1.LabelServiceName: obj.GetName()}); err != nil {
+		r.Log.Error(err, "unable to list EndpointSlices", "serviceimport", client.ObjectKeyFromObject(obj))
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(slices.Items))
+	for i := range slices.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&slices.Items[i])})
+	}
+	return requests
 }
@@ -22,11 +22,15 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
 )
 
@@ -53,7 +57,94 @@ var _ = Describe("EndpointSlice", func() {
 			})).To(BeTrue())
 		})
 	})
-	Context("created with mc label", func() {
+	Context("reconciled", func() {
+		var (
+			reconciler *EndpointSliceReconciler
+			recorder   *record.FakeRecorder
+			epSlice    *discoveryv1.EndpointSlice
+			svcImport  *v1beta1.ServiceImport
+		)
+		sliceName := types.NamespacedName{Namespace: "ns", Name: "slice"}
+		derivedServiceName := derivedName(types.NamespacedName{Namespace: "ns", Name: "svc"})
+
+		build := func() {
+			recorder = record.NewFakeRecorder(10)
+			reconciler = &EndpointSliceReconciler{
+				Client:   newFakeClient(interceptor.Funcs{}, epSlice, svcImport),
+				Log:      logr.Discard(),
+				Recorder: recorder,
+			}
+		}
+
+		reconcile := func() *discoveryv1.EndpointSlice {
+			build()
+			_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: sliceName})
+			Expect(err).ToNot(HaveOccurred())
+
+			var eps discoveryv1.EndpointSlice
+			Expect(reconciler.Client.Get(ctx, sliceName, &eps)).To(Succeed())
+			return &eps
+		}
+
+		BeforeEach(func() {
+			epSlice = &discoveryv1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: sliceName.Namespace,
+					Name:      sliceName.Name,
+					Labels:    map[string]string{v1beta1.LabelServiceName: "svc"},
+				},
+				AddressType: discoveryv1.AddressTypeIPv4,
+			}
+			svcImport = &v1beta1.ServiceImport{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   sliceName.Namespace,
+					Name:        "svc",
+					Annotations: map[string]string{DerivedServiceAnnotation: derivedServiceName},
+				},
+			}
+		})
+
+		It("relabels the EndpointSlice with its derived Service", func() {
+			Expect(reconcile().Labels).To(HaveKeyWithValue(discoveryv1.LabelServiceName, derivedServiceName))
+			Expect(recorder.Events).To(Receive(HavePrefix("Normal " + eventReasonRelabelled)))
+		})
+
+		It("leaves a correctly labelled EndpointSlice alone", func() {
+			epSlice.Labels[discoveryv1.LabelServiceName] = derivedServiceName
+			Expect(reconcile().ResourceVersion).To(Equal("999"))
+			Expect(recorder.Events).ToNot(Receive())
+		})
+
+		It("follows the derived Service named by the ServiceImport", func() {
+			svcImport.Annotations[DerivedServiceAnnotation] = "svc-mcs"
+			epSlice.Labels[discoveryv1.LabelServiceName] = derivedServiceName
+			Expect(reconcile().Labels).To(HaveKeyWithValue(discoveryv1.LabelServiceName, "svc-mcs"))
+		})
+
+		It("waits for the ServiceImport to name its derived Service", func() {
+			svcImport.Annotations = nil
+			Expect(reconcile().Labels).ToNot(HaveKey(discoveryv1.LabelServiceName))
+			Expect(recorder.Events).ToNot(Receive())
+		})
+
+		It("ignores an EndpointSlice without a ServiceImport", func() {
+			svcImport.Name = "other"
+			Expect(reconcile().Labels).ToNot(HaveKey(discoveryv1.LabelServiceName))
+		})
+
+		It("maps a ServiceImport to the EndpointSlices of its service", func() {
+			build()
+			Expect(reconciler.serviceImportToEndpointSlices(ctx, svcImport)).To(ConsistOf(
+				ctrl.Request{NamespacedName: sliceName}))
+		})
+
+		It("leaves an EndpointSlice of a local Service alone", func() {
+			epSlice.Labels = map[string]string{discoveryv1.LabelServiceName: "svc"}
+			Expect(reconcile().Labels).To(HaveKeyWithValue(discoveryv1.LabelServiceName, "svc"))
+			Expect(recorder.Events).ToNot(Receive())
+		})
+	})
+	Context("created with mc label", Label(envtestLabel), func() {
 		var (
 			serviceName        types.NamespacedName
 			derivedServiceName types.NamespacedName
@@ -75,6 +166,13 @@ var _ = Describe("EndpointSlice", func() {
 				AddressType: discoveryv1.AddressTypeIPv4,
 			}
 			Expect(k8s.Create(ctx, &epSlice)).To(Succeed())
+			Expect(k8s.Create(ctx, &v1beta1.ServiceImport{
+				ObjectMeta: metav1.ObjectMeta{Namespace: testNS, Name: serviceName.Name},
+				Spec: v1beta1.ServiceImportSpec{
+					Type:  v1beta1.ClusterSetIP,
+					Ports: []v1beta1.ServicePort{{Port: 80}},
+				},
+			})).To(Succeed())
 		})
 		It("has correct label", func() {
 			Eventually(func() string {
@@ -84,7 +182,7 @@ var _ = Describe("EndpointSlice", func() {
 			}).Should(Equal(derivedServiceName.Name))
 		})
 	})
-	Context("created with wrong label", func() {
+	Context("created with wrong label", Label(envtestLabel), func() {
 		var (
 			serviceName        types.NamespacedName
 			derivedServiceName types.NamespacedName
@@ -107,6 +205,13 @@ var _ = Describe("EndpointSlice", func() {
 				AddressType: discoveryv1.AddressTypeIPv4,
 			}
 			Expect(k8s.Create(ctx, &epSlice)).To(Succeed())
+			Expect(k8s.Create(ctx, &v1beta1.ServiceImport{
+				ObjectMeta: metav1.ObjectMeta{Namespace: testNS, Name: serviceName.Name},
+				Spec: v1beta1.ServiceImportSpec{
+					Type:  v1beta1.ClusterSetIP,
+					Ports: []v1beta1.ServicePort{{Port: 80}},
+				},
+			})).To(Succeed())
 		})
 		It("has correct label", func() {
 			Eventually(func() string {
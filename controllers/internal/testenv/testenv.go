@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package testenv starts the envtest control plane the controller suites run
+// their envtest specs against.
+package testenv
+
+import (
+	"os"
+	"slices"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+)
+
+// Label marks the specs that run against an envtest control plane. They are
+// skipped when its binaries aren't available.
+const Label = "envtest"
+
+// Start starts an envtest control plane with the CRDs of crdDir, or returns
+// nil if KUBEBUILDER_ASSETS isn't set. It must be called from a BeforeSuite.
+func Start(crdDir string) (*envtest.Environment, *rest.Config) {
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		ginkgo.GinkgoWriter.Println("KUBEBUILDER_ASSETS is not set, skipping the envtest specs")
+		return nil, nil
+	}
+
+	env := &envtest.Environment{
+		CRDDirectoryPaths:     []string{crdDir},
+		ErrorIfCRDPathMissing: true,
+	}
+	cfg, err := env.Start()
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	gomega.Expect(cfg).ToNot(gomega.BeNil())
+	return env, cfg
+}
+
+// SkipIfNotStarted skips the current spec if it's labelled with Label and env
+// wasn't started. It must be called from a BeforeEach.
+func SkipIfNotStarted(env *envtest.Environment) {
+	if env == nil && slices.Contains(ginkgo.CurrentSpecReport().Labels(), Label) {
+		ginkgo.Skip("KUBEBUILDER_ASSETS is not set")
+	}
+}
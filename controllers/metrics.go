@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+)
+
+const (
+	outOfSyncMissing = "missing"
+	outOfSyncDrifted = "drifted"
+
+	// collectTimeout bounds the time spent reading the cache on a scrape.
+	collectTimeout = 10 * time.Second
+)
+
+var (
+	endpointSlicesRelabelled = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "mcs_endpointslices_relabelled_total",
+		Help: "Number of imported EndpointSlices labelled with the name of their derived Service.",
+	})
+	ipAssignmentDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "mcs_serviceimport_ip_assignment_duration_seconds",
+		Help:    "Time from the creation of a ServiceImport until the cluster IPs of its derived Service are imported.",
+		Buckets: prometheus.ExponentialBuckets(0.1, 2, 12),
+	})
+	reconcileErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mcs_reconcile_errors_total",
+		Help: "Number of reconcile errors per controller and API status reason.",
+	}, []string{"controller", "reason"})
+
+	serviceImportsDesc = prometheus.NewDesc("mcs_serviceimports",
+		"Number of ServiceImports per type.", []string{"type"}, nil)
+	derivedServicesOutOfSyncDesc = prometheus.NewDesc("mcs_derived_services_out_of_sync",
+		"Number of ServiceImports whose derived Service is missing or drifted from the ServiceImport.", []string{"reason"}, nil)
+)
+
+func init() {
+	metrics.Registry.MustRegister(endpointSlicesRelabelled, ipAssignmentDuration, reconcileErrors)
+}
+
+// registerStateCollector registers a stateCollector reading from reader. It
+// replaces the collector registered by a previous call, so that the metrics
+// are read from the cache of the last manager set up.
+func registerStateCollector(registry prometheus.Registerer, reader client.Reader, log logr.Logger) error {
+	collector := &stateCollector{Reader: reader, Log: log}
+	err := registry.Register(collector)
+	var registered prometheus.AlreadyRegisteredError
+	if errors.As(err, &registered) {
+		registry.Unregister(registered.ExistingCollector)
+		err = registry.Register(collector)
+	}
+	return err
+}
+
+// countErrors wraps a reconciler to count its errors by reason.
+func countErrors(controller string, r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		result, err := r.Reconcile(ctx, req)
+		if err != nil {
+			reason := apierrors.ReasonForError(err)
+			if reason == "" {
+				reason = "Unknown"
+			}
+			reconcileErrors.WithLabelValues(controller, string(reason)).Inc()
+		}
+		return result, err
+	})
+}
+
+// stateCollector reports the ServiceImports and the state of their derived
+// Services, as read from the cache on each scrape.
+type stateCollector struct {
+	client.Reader
+	Log logr.Logger
+}
+
+var _ prometheus.Collector = &stateCollector{}
+
+// Describe implements prometheus.Collector.
+func (c *stateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- serviceImportsDesc
+	ch <- derivedServicesOutOfSyncDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *stateCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	imports := map[v1beta1.ServiceImportType]int{v1beta1.ClusterSetIP: 0, v1beta1.Headless: 0}
+	outOfSync := map[string]int{outOfSyncMissing: 0, outOfSyncDrifted: 0}
+	var svcImports v1beta1.ServiceImportList
+	if err := c.Reader.List(ctx, &svcImports); err != nil {
+		c.Log.Error(err, "unable to list ServiceImports")
+		return
+	}
+	for i := range svcImports.Items {
+		svcImport := &svcImports.Items[i]
+		imports[svcImport.Spec.Type]++
+		if shouldIgnoreImport(svcImport) {
+			continue
+		}
+		reason, err := c.derivedServiceState(ctx, svcImport)
+		if err != nil {
+			c.Log.Error(err, "unable to get derived Service", "serviceimport", client.ObjectKeyFromObject(svcImport))
+			return
+		}
+		if reason != "" {
+			outOfSync[reason]++
+		}
+	}
+
+	for svcType, count := range imports {
+		ch <- prometheus.MustNewConstMetric(serviceImportsDesc, prometheus.GaugeValue, float64(count), string(svcType))
+	}
+	for reason, count := range outOfSync {
+		ch <- prometheus.MustNewConstMetric(derivedServicesOutOfSyncDesc, prometheus.GaugeValue, float64(count), reason)
+	}
+}
+
+// derivedServiceState returns why the derived Service of the ServiceImport is
+// out of sync, or an empty string if it matches the ServiceImport.
+func (c *stateCollector) derivedServiceState(ctx context.Context, svcImport *v1beta1.ServiceImport) (string, error) {
+	name := svcImport.Annotations[DerivedServiceAnnotation]
+	if name == "" {
+		return outOfSyncMissing, nil
+	}
+	var svc v1.Service
+	if err := c.Reader.Get(ctx, types.NamespacedName{Namespace: svcImport.Namespace, Name: name}, &svc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return outOfSyncMissing, nil
+		}
+		return "", err
+	}
+	if requiresRecreate(&svc, svcImport) || updateDerivedService(svc.DeepCopy(), svcImport) {
+		return outOfSyncDrifted, nil
+	}
+	return "", nil
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+)
+
+var _ = Describe("Metrics", func() {
+	ctx := context.Background()
+
+	newImport := func(name string, svcType v1beta1.ServiceImportType) *v1beta1.ServiceImport {
+		return &v1beta1.ServiceImport{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "ns",
+				Name:        name,
+				Annotations: map[string]string{DerivedServiceAnnotation: "derived-" + name},
+			},
+			Spec: v1beta1.ServiceImportSpec{
+				Type:  svcType,
+				Ports: []v1beta1.ServicePort{{Name: "http", Protocol: v1.ProtocolTCP, Port: 80}},
+			},
+		}
+	}
+
+	derivedService := func(svcImport *v1beta1.ServiceImport) *v1.Service {
+		return &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: svcImport.Annotations[DerivedServiceAnnotation]},
+			Spec:       derivedServiceSpec(svcImport),
+		}
+	}
+
+	Context("reconcile errors", func() {
+		failWith := func(err error) reconcile.Reconciler {
+			return countErrors("test", reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+				return reconcile.Result{}, err
+			}))
+		}
+
+		It("are counted by reason", func() {
+			conflicts := testutil.ToFloat64(reconcileErrors.WithLabelValues("test", string(metav1.StatusReasonConflict)))
+			unknown := testutil.ToFloat64(reconcileErrors.WithLabelValues("test", "Unknown"))
+
+			_, err := failWith(apierrors.NewConflict(schema.GroupResource{Resource: "services"}, "svc", errors.New("conflict"))).
+				Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			_, err = failWith(errors.New("boom")).Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			_, err = failWith(nil).Reconcile(ctx, reconcile.Request{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(testutil.ToFloat64(reconcileErrors.WithLabelValues("test", string(metav1.StatusReasonConflict)))).To(Equal(conflicts + 1))
+			Expect(testutil.ToFloat64(reconcileErrors.WithLabelValues("test", "Unknown"))).To(Equal(unknown + 1))
+		})
+	})
+
+	Context("EndpointSlice relabelling", func() {
+		It("is counted", func() {
+			before := testutil.ToFloat64(endpointSlicesRelabelled)
+			epSlice := &discoveryv1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      "slice",
+					Labels:    map[string]string{v1beta1.LabelServiceName: "svc"},
+				},
+				AddressType: discoveryv1.AddressTypeIPv4,
+			}
+			r := &EndpointSliceReconciler{
+				Client:   newFakeClient(interceptor.Funcs{}, epSlice, newImport("svc", v1beta1.ClusterSetIP)),
+				Log:      logr.Discard(),
+				Recorder: record.NewFakeRecorder(10),
+			}
+
+			for range 2 {
+				_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(epSlice)})
+				Expect(err).ToNot(HaveOccurred())
+			}
+			Expect(testutil.ToFloat64(endpointSlicesRelabelled)).To(Equal(before + 1))
+		})
+	})
+
+	Context("IP assignment duration", func() {
+		sampleCount := func() uint64 {
+			var m dto.Metric
+			Expect(ipAssignmentDuration.Write(&m)).To(Succeed())
+			return m.GetHistogram().GetSampleCount()
+		}
+
+		It("is observed when the IPs are first imported", func() {
+			before := sampleCount()
+			svcImport := newImport("svc", v1beta1.ClusterSetIP)
+			svcImport.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Minute))
+			svc := derivedService(svcImport)
+			svc.OwnerReferences = []metav1.OwnerReference{{
+				APIVersion: v1beta1.GroupVersion.String(),
+				Kind:       serviceImportKind,
+				Name:       svcImport.Name,
+			}}
+			svc.Spec.ClusterIP = "10.0.0.1"
+			svc.Spec.ClusterIPs = []string{"10.0.0.1"}
+			c := newFakeClient(interceptor.Funcs{}, svcImport, svc)
+			r := &ServiceReconciler{Client: c, Log: logr.Discard(), Recorder: record.NewFakeRecorder(10)}
+
+			_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(svc)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sampleCount()).To(Equal(before + 1))
+
+			// A change of IPs isn't an assignment.
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(svc), svc)).To(Succeed())
+			svc.Spec.ClusterIPs = []string{"10.0.0.2"}
+			Expect(c.Update(ctx, svc)).To(Succeed())
+			_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(svc)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sampleCount()).To(Equal(before + 1))
+		})
+	})
+
+	Context("state collector", func() {
+		It("reports ServiceImports by type and out of sync derived Services", func() {
+			inSync := newImport("in-sync", v1beta1.ClusterSetIP)
+			missing := newImport("missing", v1beta1.ClusterSetIP)
+			notAnnotated := newImport("not-annotated", v1beta1.ClusterSetIP)
+			delete(notAnnotated.Annotations, DerivedServiceAnnotation)
+			drifted := newImport("drifted", v1beta1.Headless)
+			driftedSvc := derivedService(drifted)
+			driftedSvc.Spec.Ports[0].Port = 8080
+			recreated := newImport("recreated", v1beta1.Headless)
+			recreatedSvc := derivedService(recreated)
+			recreatedSvc.Spec.ClusterIP = "10.0.0.1"
+
+			collector := &stateCollector{
+				Reader: newFakeClient(interceptor.Funcs{}, inSync, derivedService(inSync), missing, notAnnotated, drifted, driftedSvc, recreated, recreatedSvc),
+				Log:    logr.Discard(),
+			}
+			Expect(testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP mcs_serviceimports Number of ServiceImports per type.
+# TYPE mcs_serviceimports gauge
+mcs_serviceimports{type="ClusterSetIP"} 3
+mcs_serviceimports{type="Headless"} 2
+# HELP mcs_derived_services_out_of_sync Number of ServiceImports whose derived Service is missing or drifted from the ServiceImport.
+# TYPE mcs_derived_services_out_of_sync gauge
+mcs_derived_services_out_of_sync{reason="drifted"} 2
+mcs_derived_services_out_of_sync{reason="missing"} 2
+`))).To(Succeed())
+		})
+
+		It("is replaced when registered again", func() {
+			registry := prometheus.NewRegistry()
+			Expect(registerStateCollector(registry, newFakeClient(interceptor.Funcs{}), logr.Discard())).To(Succeed())
+			Expect(registerStateCollector(registry, newFakeClient(interceptor.Funcs{}, newImport("svc", v1beta1.Headless)), logr.Discard())).To(Succeed())
+			Expect(testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP mcs_serviceimports Number of ServiceImports per type.
+# TYPE mcs_serviceimports gauge
+mcs_serviceimports{type="ClusterSetIP"} 0
+mcs_serviceimports{type="Headless"} 1
+`), "mcs_serviceimports")).To(Succeed())
+		})
+
+		It("reports nothing when the cache can't be read", func() {
+			collector := &stateCollector{
+				Reader: newFakeClient(interceptor.Funcs{
+					List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+						return errors.New("cache not synced")
+					},
+				}),
+				Log: logr.Discard(),
+			}
+			Expect(testutil.CollectAndCount(collector)).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,214 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package migration rewrites stored ServiceExport and ServiceImport objects
+// in the current storage version of their CRDs.
+package migration
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+	mcsclient "sigs.k8s.io/mcs-api/pkg/client/clientset/versioned"
+)
+
+// DefaultPageSize is the number of objects listed per request when
+// Migrator.PageSize is not set.
+const DefaultPageSize = 500
+
+// Migrator rewrites every ServiceExport and ServiceImport so that the API
+// server stores it in the CRD's storage version, then drops the older versions
+// from the CRD's status.storedVersions.
+//
+// Rewriting an object is idempotent and storedVersions is only updated once all
+// objects of a CRD were rewritten, so an interrupted migration is resumed by
+// running it again.
+type Migrator struct {
+	Client    mcsclient.Interface
+	CRDClient apiextensionsclient.Interface
+	Log       logr.Logger
+	// PageSize limits the number of objects listed per request.
+	PageSize int64
+}
+
+type resource struct {
+	crdName string
+	list    func(ctx context.Context, opts metav1.ListOptions) ([]metav1.Object, string, error)
+	update  func(ctx context.Context, obj metav1.Object) error
+}
+
+func (m *Migrator) resources() []resource {
+	return []resource{
+		{
+			crdName: v1beta1.ServiceExportFullName,
+			list: func(ctx context.Context, opts metav1.ListOptions) ([]metav1.Object, string, error) {
+				list, err := m.Client.MulticlusterV1beta1().ServiceExports(metav1.NamespaceAll).List(ctx, opts)
+				if err != nil {
+					return nil, "", err
+				}
+				objs := make([]metav1.Object, len(list.Items))
+				for i := range list.Items {
+					objs[i] = &list.Items[i]
+				}
+				return objs, list.Continue, nil
+			},
+			update: func(ctx context.Context, obj metav1.Object) error {
+				svcExport := obj.(*v1beta1.ServiceExport)
+				_, err := m.Client.MulticlusterV1beta1().ServiceExports(svcExport.Namespace).Update(ctx, svcExport, metav1.UpdateOptions{})
+				return err
+			},
+		},
+		{
+			crdName: v1beta1.ServiceImportFullName,
+			list: func(ctx context.Context, opts metav1.ListOptions) ([]metav1.Object, string, error) {
+				list, err := m.Client.MulticlusterV1beta1().ServiceImports(metav1.NamespaceAll).List(ctx, opts)
+				if err != nil {
+					return nil, "", err
+				}
+				objs := make([]metav1.Object, len(list.Items))
+				for i := range list.Items {
+					objs[i] = &list.Items[i]
+				}
+				return objs, list.Continue, nil
+			},
+			update: func(ctx context.Context, obj metav1.Object) error {
+				svcImport := obj.(*v1beta1.ServiceImport)
+				_, err := m.Client.MulticlusterV1beta1().ServiceImports(svcImport.Namespace).Update(ctx, svcImport, metav1.UpdateOptions{})
+				return err
+			},
+		},
+	}
+}
+
+// Run migrates all resources, stopping at the first error.
+func (m *Migrator) Run(ctx context.Context) error {
+	for _, res := range m.resources() {
+		if err := m.migrate(ctx, res); err != nil {
+			return fmt.Errorf("error migrating %s: %w", res.crdName, err)
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) migrate(ctx context.Context, res resource) error {
+	log := m.Log.WithValues("resource", res.crdName)
+
+	crd, err := m.CRDClient.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, res.crdName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	storageVersion := storageVersionOf(crd)
+	if storageVersion == "" {
+		return fmt.Errorf("CRD %s has no storage version", res.crdName)
+	}
+	if slices.Equal(crd.Status.StoredVersions, []string{storageVersion}) {
+		log.Info("already migrated", "storageVersion", storageVersion)
+		return nil
+	}
+
+	log.Info("migrating", "storageVersion", storageVersion, "storedVersions", crd.Status.StoredVersions)
+	total, err := m.rewriteAll(ctx, res, log)
+	if err != nil {
+		return err
+	}
+
+	if err := m.updateStoredVersions(ctx, res.crdName, storageVersion); err != nil {
+		return err
+	}
+	log.Info("migrated", "objects", total, "storedVersions", []string{storageVersion})
+	return nil
+}
+
+// rewriteAll updates every object of the resource without modifying it, which
+// makes the API server persist it in the storage version. Objects are listed
+// ordered by namespace, so progress is reported as each namespace completes.
+func (m *Migrator) rewriteAll(ctx context.Context, res resource, log logr.Logger) (int, error) {
+	pageSize := m.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	total := 0
+	namespace := ""
+	inNamespace := 0
+	opts := metav1.ListOptions{Limit: pageSize}
+	for {
+		objs, cont, err := res.list(ctx, opts)
+		if apierrors.IsResourceExpired(err) {
+			// The continue token expired, start again from the beginning.
+			log.Info("list expired, restarting")
+			opts.Continue = ""
+			total, namespace, inNamespace = 0, "", 0
+			continue
+		}
+		if err != nil {
+			return total, err
+		}
+		for _, obj := range objs {
+			if obj.GetNamespace() != namespace {
+				if inNamespace > 0 {
+					log.Info("migrated namespace", "namespace", namespace, "objects", inNamespace)
+				}
+				namespace = obj.GetNamespace()
+				inNamespace = 0
+			}
+			// A conflict means the object was written since it was listed and
+			// a missing object needs no migration, either way it's done.
+			if err := res.update(ctx, obj); err != nil && !apierrors.IsConflict(err) && !apierrors.IsNotFound(err) {
+				return total, fmt.Errorf("error rewriting %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+			}
+			inNamespace++
+			total++
+		}
+		if cont == "" {
+			break
+		}
+		opts.Continue = cont
+	}
+	if inNamespace > 0 {
+		log.Info("migrated namespace", "namespace", namespace, "objects", inNamespace)
+	}
+	return total, nil
+}
+
+func (m *Migrator) updateStoredVersions(ctx context.Context, crdName, storageVersion string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		crd, err := m.CRDClient.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, crdName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		crd.Status.StoredVersions = []string{storageVersion}
+		_, err = m.CRDClient.ApiextensionsV1().CustomResourceDefinitions().UpdateStatus(ctx, crd, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func storageVersionOf(crd *apiextensionsv1.CustomResourceDefinition) string {
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			return version.Name
+		}
+	}
+	return ""
+}
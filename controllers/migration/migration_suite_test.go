@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/mcs-api/controllers/internal/testenv"
+)
+
+// envtestLabel marks the specs that migrate objects stored by an envtest
+// control plane.
+const envtestLabel = testenv.Label
+
+var (
+	cfg *rest.Config
+	env *envtest.Environment
+)
+
+var _ = BeforeSuite(func() {
+	env, cfg = testenv.Start(filepath.Join("..", "..", "config", "crd"))
+})
+
+var _ = BeforeEach(func() {
+	testenv.SkipIfNotStarted(env)
+})
+
+var _ = AfterSuite(func() {
+	if env == nil {
+		return
+	}
+	Expect(env.Stop()).To(Succeed())
+})
+
+func TestMigration(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Migration Suite")
+}
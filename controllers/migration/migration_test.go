@@ -0,0 +1,236 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	crdfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+	mcsclient "sigs.k8s.io/mcs-api/pkg/client/clientset/versioned"
+	mcsfake "sigs.k8s.io/mcs-api/pkg/client/clientset/versioned/fake"
+)
+
+func newCRD(name string, storedVersions ...string) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: v1beta1.GroupName,
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1alpha1", Served: true},
+				{Name: "v1beta1", Served: true, Storage: true},
+			},
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{
+			StoredVersions: storedVersions,
+		},
+	}
+}
+
+func updatedObjects(client *mcsfake.Clientset, resource string) []string {
+	var names []string
+	for _, action := range client.Actions() {
+		if update, ok := action.(k8stesting.UpdateAction); ok && action.GetResource().Resource == resource {
+			obj := update.GetObject().(metav1.Object)
+			names = append(names, obj.GetNamespace()+"/"+obj.GetName())
+		}
+	}
+	return names
+}
+
+var _ = Describe("Migrator", func() {
+	var (
+		client    *mcsfake.Clientset
+		crdClient *crdfake.Clientset
+		migrator  *Migrator
+		ctx       = context.Background()
+	)
+
+	storedVersions := func(name string) []string {
+		crd, err := crdClient.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return crd.Status.StoredVersions
+	}
+
+	BeforeEach(func() {
+		client = mcsfake.NewSimpleClientset(
+			&v1beta1.ServiceExport{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "svc1"}},
+			&v1beta1.ServiceExport{ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "svc2"}},
+			&v1beta1.ServiceImport{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "svc1"}},
+		)
+		crdClient = crdfake.NewSimpleClientset(
+			newCRD(v1beta1.ServiceExportFullName, "v1alpha1", "v1beta1"),
+			newCRD(v1beta1.ServiceImportFullName, "v1alpha1", "v1beta1"),
+		)
+		migrator = &Migrator{Client: client, CRDClient: crdClient, Log: logr.Discard()}
+	})
+
+	It("rewrites every object and updates the stored versions", func() {
+		Expect(migrator.Run(ctx)).To(Succeed())
+
+		Expect(updatedObjects(client, v1beta1.ServiceExportPluralName)).To(ConsistOf("ns1/svc1", "ns2/svc2"))
+		Expect(updatedObjects(client, v1beta1.ServiceImportPluralName)).To(ConsistOf("ns1/svc1"))
+		Expect(storedVersions(v1beta1.ServiceExportFullName)).To(Equal([]string{"v1beta1"}))
+		Expect(storedVersions(v1beta1.ServiceImportFullName)).To(Equal([]string{"v1beta1"}))
+	})
+
+	It("skips resources that are already migrated", func() {
+		crdClient = crdfake.NewSimpleClientset(
+			newCRD(v1beta1.ServiceExportFullName, "v1beta1"),
+			newCRD(v1beta1.ServiceImportFullName, "v1alpha1", "v1beta1"),
+		)
+		migrator.CRDClient = crdClient
+
+		Expect(migrator.Run(ctx)).To(Succeed())
+
+		Expect(updatedObjects(client, v1beta1.ServiceExportPluralName)).To(BeEmpty())
+		Expect(updatedObjects(client, v1beta1.ServiceImportPluralName)).To(ConsistOf("ns1/svc1"))
+		Expect(storedVersions(v1beta1.ServiceImportFullName)).To(Equal([]string{"v1beta1"}))
+	})
+
+	It("ignores objects modified or deleted while migrating", func() {
+		client.PrependReactor("update", v1beta1.ServiceExportPluralName,
+			func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if action.GetNamespace() == "ns1" {
+					return true, nil, apierrors.NewConflict(v1beta1.Resource(v1beta1.ServiceExportPluralName), "svc1", errors.New("modified"))
+				}
+				return true, nil, apierrors.NewNotFound(v1beta1.Resource(v1beta1.ServiceExportPluralName), "svc2")
+			})
+
+		Expect(migrator.Run(ctx)).To(Succeed())
+		Expect(storedVersions(v1beta1.ServiceExportFullName)).To(Equal([]string{"v1beta1"}))
+	})
+
+	It("leaves the stored versions untouched when rewriting fails", func() {
+		client.PrependReactor("update", v1beta1.ServiceImportPluralName,
+			func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, errors.New("boom")
+			})
+
+		Expect(migrator.Run(ctx)).ToNot(Succeed())
+		Expect(storedVersions(v1beta1.ServiceExportFullName)).To(Equal([]string{"v1beta1"}))
+		Expect(storedVersions(v1beta1.ServiceImportFullName)).To(Equal([]string{"v1alpha1", "v1beta1"}))
+
+		// Running again after the failure is resolved completes the migration.
+		client.ReactionChain = client.ReactionChain[1:]
+		Expect(migrator.Run(ctx)).To(Succeed())
+		Expect(storedVersions(v1beta1.ServiceImportFullName)).To(Equal([]string{"v1beta1"}))
+	})
+
+	It("fails when the CRD is missing", func() {
+		migrator.CRDClient = crdfake.NewSimpleClientset()
+		Expect(migrator.Run(ctx)).ToNot(Succeed())
+	})
+})
+
+var _ = Describe("Migrator against an API server", Label(envtestLabel), func() {
+	var (
+		client    mcsclient.Interface
+		crdClient apiextensionsclient.Interface
+		ctx       = context.Background()
+	)
+	crdNames := []string{v1beta1.ServiceExportFullName, v1beta1.ServiceImportFullName}
+
+	getCRD := func(name string) *apiextensionsv1.CustomResourceDefinition {
+		crd, err := crdClient.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return crd
+	}
+
+	setStorageVersion := func(name, version string) {
+		Expect(retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			crd := getCRD(name)
+			for i := range crd.Spec.Versions {
+				crd.Spec.Versions[i].Storage = crd.Spec.Versions[i].Name == version
+			}
+			_, err := crdClient.ApiextensionsV1().CustomResourceDefinitions().Update(ctx, crd, metav1.UpdateOptions{})
+			return err
+		})).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		var err error
+		client, err = mcsclient.NewForConfig(cfg)
+		Expect(err).ToNot(HaveOccurred())
+		crdClient, err = apiextensionsclient.NewForConfig(cfg)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("migrates objects stored in an older served version", func() {
+		for _, name := range crdNames {
+			served := slices.DeleteFunc(getCRD(name).Spec.Versions, func(v apiextensionsv1.CustomResourceDefinitionVersion) bool {
+				return !v.Served
+			})
+			Expect(served).To(HaveLen(2))
+		}
+
+		namespace := fmt.Sprintf("migration-%v", rand.Uint64())
+		k8sClient, err := kubernetes.NewForConfig(cfg)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = k8sClient.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}},
+			metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		// Store the objects in v1alpha1, then switch the storage version back.
+		for _, name := range crdNames {
+			setStorageVersion(name, "v1alpha1")
+		}
+		_, err = client.MulticlusterV1alpha1().ServiceExports(namespace).Create(ctx,
+			&v1alpha1.ServiceExport{ObjectMeta: metav1.ObjectMeta{Name: "svc"}}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = client.MulticlusterV1alpha1().ServiceImports(namespace).Create(ctx, &v1alpha1.ServiceImport{
+			ObjectMeta: metav1.ObjectMeta{Name: "svc"},
+			Spec: v1alpha1.ServiceImportSpec{
+				Type:  v1alpha1.ClusterSetIP,
+				Ports: []v1alpha1.ServicePort{{Protocol: corev1.ProtocolTCP, Port: 80}},
+			},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		for _, name := range crdNames {
+			setStorageVersion(name, "v1beta1")
+			Expect(getCRD(name).Status.StoredVersions).To(ConsistOf("v1alpha1", "v1beta1"))
+		}
+
+		migrator := &Migrator{Client: client, CRDClient: crdClient, Log: logr.Discard(), PageSize: 1}
+		Expect(migrator.Run(ctx)).To(Succeed())
+
+		for _, name := range crdNames {
+			Expect(getCRD(name).Status.StoredVersions).To(Equal([]string{"v1beta1"}))
+		}
+		_, err = client.MulticlusterV1beta1().ServiceExports(namespace).Get(ctx, "svc", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = client.MulticlusterV1alpha1().ServiceImports(namespace).Get(ctx, "svc", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// NamingStrategy names the derived Services of ServiceImports. A name may be
+// taken by a Service that isn't derived from the ServiceImport, the controllers
+// report such collisions on the ServiceImport and leave the Service alone.
+type NamingStrategy interface {
+	// DerivedName returns the name of the derived Service of the named
+	// ServiceImport. It must be a valid Service name.
+	DerivedName(name types.NamespacedName) string
+}
+
+// HashNamingStrategy names derived Services "derived-" followed by a hash of
+// the ServiceImport's namespace and name. It is the default strategy.
+type HashNamingStrategy struct{}
+
+var _ NamingStrategy = HashNamingStrategy{}
+
+// DerivedName implements NamingStrategy.
+func (HashNamingStrategy) DerivedName(name types.NamespacedName) string {
+	return derivedName(name)
+}
+
+// SuffixNamingStrategy names derived Services after their ServiceImport with
+// an "-mcs" suffix. Names too long to be suffixed are shortened and
+// disambiguated with a hash.
+type SuffixNamingStrategy struct{}
+
+var _ NamingStrategy = SuffixNamingStrategy{}
+
+const derivedNameSuffix = "-mcs"
+
+// DerivedName implements NamingStrategy.
+func (SuffixNamingStrategy) DerivedName(name types.NamespacedName) string {
+	if len(name.Name)+len(derivedNameSuffix) <= validation.DNS1035LabelMaxLength {
+		return name.Name + derivedNameSuffix
+	}
+	hash := "-" + nameHash(name)[:5]
+	return name.Name[:validation.DNS1035LabelMaxLength-len(hash)-len(derivedNameSuffix)] + hash + derivedNameSuffix
+}
+
+// NamingStrategies are the naming strategies by the name operators select them
+// with.
+var NamingStrategies = map[string]NamingStrategy{
+	"hash":   HashNamingStrategy{},
+	"suffix": SuffixNamingStrategy{},
+}
+
+func derivedName(name types.NamespacedName) string {
+	return "derived-" + nameHash(name)[:10]
+}
+
+func nameHash(name types.NamespacedName) string {
+	hash := sha256.New()
+	hash.Write([]byte(name.String()))
+	return strings.ToLower(base32.HexEncoding.WithPadding(base32.NoPadding).EncodeToString(hash.Sum(nil)))
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+var _ = Describe("NamingStrategy", func() {
+	name := types.NamespacedName{Namespace: "ns", Name: "svc"}
+
+	Context("hash", func() {
+		It("hashes the namespace and name", func() {
+			Expect(HashNamingStrategy{}.DerivedName(name)).To(MatchRegexp(`^derived-[0-9a-v]{10}$`))
+			Expect(HashNamingStrategy{}.DerivedName(name)).ToNot(Equal(
+				HashNamingStrategy{}.DerivedName(types.NamespacedName{Namespace: "other", Name: "svc"})))
+		})
+	})
+
+	Context("suffix", func() {
+		It("suffixes the name", func() {
+			Expect(SuffixNamingStrategy{}.DerivedName(name)).To(Equal("svc-mcs"))
+		})
+		It("shortens long names and keeps them distinct", func() {
+			long := strings.Repeat("a", validation.DNS1035LabelMaxLength)
+			derived := SuffixNamingStrategy{}.DerivedName(types.NamespacedName{Namespace: "ns", Name: long})
+			Expect(validation.IsDNS1035Label(derived)).To(BeEmpty())
+			Expect(derived).To(HaveSuffix("-mcs"))
+			Expect(derived).ToNot(Equal(SuffixNamingStrategy{}.DerivedName(types.NamespacedName{Namespace: "ns", Name: long[1:] + "b"})))
+		})
+	})
+})
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+)
+
+// DefaultLeaderElectionID is the name of the lease used for leader election
+// when Options.LeaderElectionID is not set.
+const DefaultLeaderElectionID = "mcs-controllers.multicluster.x-k8s.io"
+
+// Options configures the controllers and the manager created by Start.
+type Options struct {
+	// Scheme of the manager, defaulting to a scheme with the client-go,
+	// v1alpha1 and v1beta1 types.
+	Scheme *runtime.Scheme
+	// Namespaces restricts all the watches of the controllers to the given
+	// namespaces, all namespaces are watched if empty.
+	Namespaces []string
+
+	// ServiceImportConcurrency, ServiceConcurrency, ServiceExportConcurrency
+	// and EndpointSliceConcurrency are the maximum numbers of concurrent
+	// reconciles of each controller, defaulting to 1.
+	ServiceImportConcurrency int
+	ServiceConcurrency       int
+	ServiceExportConcurrency int
+	EndpointSliceConcurrency int
+
+	// MetricsAddr is the address the metrics endpoint binds to, "0" to
+	// disable it.
+	MetricsAddr string
+	// HealthProbeAddr is the address the health and readiness probes bind to,
+	// none if empty.
+	HealthProbeAddr string
+
+	// LeaderElection ensures there is only one active controller manager.
+	LeaderElection bool
+	// LeaderElectionID is the name of the lease, defaulting to
+	// DefaultLeaderElectionID.
+	LeaderElectionID string
+	// LeaderElectionNamespace is the namespace of the lease, defaulting to
+	// the namespace the manager runs in.
+	LeaderElectionNamespace string
+
+	// EnableWebhooks serves the CRD conversion and ServiceExport validating
+	// webhooks.
+	EnableWebhooks bool
+	// WebhookPort is the port the webhook server listens on, defaulting to
+	// 9443.
+	WebhookPort int
+
+	// Sweeper configures the orphan sweeper.
+	Sweeper SweeperOptions
+	// Naming names the derived Services, defaulting to HashNamingStrategy.
+	Naming NamingStrategy
+}
+
+// ManagerOptions returns the options of a manager running the controllers.
+func (o *Options) ManagerOptions() ctrl.Options {
+	opts := ctrl.Options{
+		Scheme:                  o.Scheme,
+		Metrics:                 server.Options{BindAddress: o.MetricsAddr},
+		HealthProbeBindAddress:  o.HealthProbeAddr,
+		LeaderElection:          o.LeaderElection,
+		LeaderElectionID:        o.LeaderElectionID,
+		LeaderElectionNamespace: o.LeaderElectionNamespace,
+	}
+	opts.Cache.ByObject = CacheByObject()
+	if opts.Scheme == nil {
+		opts.Scheme = NewScheme()
+	}
+	if opts.LeaderElectionID == "" {
+		opts.LeaderElectionID = DefaultLeaderElectionID
+	}
+	if len(o.Namespaces) > 0 {
+		opts.Cache.DefaultNamespaces = make(map[string]cache.Config, len(o.Namespaces))
+		for _, namespace := range o.Namespaces {
+			opts.Cache.DefaultNamespaces[namespace] = cache.Config{}
+		}
+	}
+	if o.EnableWebhooks {
+		opts.WebhookServer = webhook.NewServer(webhook.Options{Port: o.WebhookPort})
+	}
+	return opts
+}
+
+// NewScheme returns a scheme with the types used by the controllers.
+func NewScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(v1alpha1.AddToScheme(scheme))
+	utilruntime.Must(v1beta1.AddToScheme(scheme))
+	return scheme
+}
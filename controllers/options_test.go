@@ -0,0 +1,58 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+)
+
+var _ = Describe("Options", func() {
+	It("defaults the manager options", func() {
+		opts := (&Options{}).ManagerOptions()
+		Expect(opts.Scheme.Recognizes(v1beta1.SchemeGroupVersion.WithKind(v1beta1.ServiceImportKindName))).To(BeTrue())
+		Expect(opts.LeaderElectionID).To(Equal(DefaultLeaderElectionID))
+		Expect(opts.Cache.DefaultNamespaces).To(BeEmpty())
+		Expect(opts.Cache.ByObject).To(HaveLen(2))
+		Expect(opts.WebhookServer).To(BeNil())
+	})
+
+	It("maps the options to the manager options", func() {
+		scheme := runtime.NewScheme()
+		opts := (&Options{
+			Scheme:                  scheme,
+			Namespaces:              []string{"ns1", "ns2"},
+			MetricsAddr:             ":9090",
+			HealthProbeAddr:         ":9091",
+			LeaderElection:          true,
+			LeaderElectionID:        "id",
+			LeaderElectionNamespace: "system",
+			EnableWebhooks:          true,
+		}).ManagerOptions()
+		Expect(opts.Scheme).To(BeIdenticalTo(scheme))
+		Expect(opts.Cache.DefaultNamespaces).To(Equal(map[string]cache.Config{"ns1": {}, "ns2": {}}))
+		Expect(opts.Metrics.BindAddress).To(Equal(":9090"))
+		Expect(opts.HealthProbeBindAddress).To(Equal(":9091"))
+		Expect(opts.LeaderElection).To(BeTrue())
+		Expect(opts.LeaderElectionID).To(Equal("id"))
+		Expect(opts.LeaderElectionNamespace).To(Equal("system"))
+		Expect(opts.WebhookServer).ToNot(BeNil())
+	})
+})
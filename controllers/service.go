@@ -19,20 +19,29 @@ package controllers
 import (
 	"context"
 	"slices"
+	"time"
 
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
 )
 
+const (
+	eventReasonIPsAssigned        = "IPsAssigned"
+	eventReasonIPAssignmentFailed = "IPAssignmentFailed"
+)
+
 // ServiceReconciler reconciles a Service object
 type ServiceReconciler struct {
 	client.Client
-	Log logr.Logger
+	Log      logr.Logger
+	Recorder record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch
@@ -74,15 +83,24 @@ func (r *ServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{}, nil
 	}
 
+	firstAssignment := len(svcImport.Spec.IPs) == 0 && len(desiredIPs) > 0
 	svcImport.Spec.IPs = desiredIPs
 	if err := r.Client.Update(ctx, &svcImport); err != nil {
+		r.Recorder.Eventf(&svcImport, v1.EventTypeWarning, eventReasonIPAssignmentFailed,
+			"Failed to assign IPs %v of derived Service %s: %v", desiredIPs, service.Name, err)
 		return ctrl.Result{}, err
 	}
 	log.Info("updated serviceimport ip", "ip", service.Spec.ClusterIP)
+	if firstAssignment {
+		ipAssignmentDuration.Observe(time.Since(svcImport.CreationTimestamp.Time).Seconds())
+	}
+	r.Recorder.Eventf(&svcImport, v1.EventTypeNormal, eventReasonIPsAssigned,
+		"Assigned IPs %v of derived Service %s", desiredIPs, service.Name)
 	return ctrl.Result{}, nil
 }
 
 // SetupWithManager wires up the controller.
-func (r *ServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).For(&v1.Service{}).Complete(r)
+func (r *ServiceReconciler) SetupWithManager(mgr ctrl.Manager, opts controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).For(&v1.Service{}).WithOptions(opts).
+		Complete(countErrors("service", r))
 }
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+)
+
+var _ = Describe("Service", func() {
+	ctx := context.Background()
+	Context("reconciled", func() {
+		var (
+			reconciler *ServiceReconciler
+			recorder   *record.FakeRecorder
+			svcImport  *v1beta1.ServiceImport
+			service    *v1.Service
+		)
+		importName := types.NamespacedName{Namespace: "ns", Name: "svc"}
+
+		reconcile := func(funcs interceptor.Funcs) error {
+			recorder = record.NewFakeRecorder(10)
+			reconciler = &ServiceReconciler{
+				Client:   newFakeClient(funcs, svcImport, service),
+				Log:      logr.Discard(),
+				Recorder: recorder,
+			}
+			_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(service)})
+			return err
+		}
+
+		importedIPs := func() []string {
+			Expect(reconciler.Client.Get(ctx, importName, svcImport)).To(Succeed())
+			return svcImport.Spec.IPs
+		}
+
+		BeforeEach(func() {
+			svcImport = &v1beta1.ServiceImport{
+				ObjectMeta: metav1.ObjectMeta{Namespace: importName.Namespace, Name: importName.Name},
+				Spec:       v1beta1.ServiceImportSpec{Type: v1beta1.ClusterSetIP},
+			}
+			service = &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: importName.Namespace,
+					Name:      derivedName(importName),
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: v1beta1.GroupVersion.String(),
+						Kind:       serviceImportKind,
+						Name:       importName.Name,
+						Controller: ptr.To(true),
+					}},
+				},
+				Spec: v1.ServiceSpec{
+					ClusterIP:  "10.96.0.1",
+					ClusterIPs: []string{"10.96.0.1", "fd00::1"},
+				},
+			}
+		})
+
+		It("imports the cluster IPs of the derived Service", func() {
+			Expect(reconcile(interceptor.Funcs{})).To(Succeed())
+			Expect(importedIPs()).To(Equal([]string{"10.96.0.1", "fd00::1"}))
+			Expect(recorder.Events).To(Receive(HavePrefix("Normal " + eventReasonIPsAssigned)))
+		})
+
+		It("imports no IPs for a headless derived Service", func() {
+			svcImport.Spec.IPs = []string{"10.96.0.1"}
+			service.Spec.ClusterIP = v1.ClusterIPNone
+			service.Spec.ClusterIPs = []string{v1.ClusterIPNone}
+			Expect(reconcile(interceptor.Funcs{})).To(Succeed())
+			Expect(importedIPs()).To(BeEmpty())
+		})
+
+		It("leaves an up to date ServiceImport alone", func() {
+			svcImport.Spec.IPs = []string{"10.96.0.1", "fd00::1"}
+			Expect(reconcile(interceptor.Funcs{})).To(Succeed())
+			Expect(recorder.Events).ToNot(Receive())
+		})
+
+		It("ignores Services not derived from a ServiceImport", func() {
+			service.OwnerReferences = nil
+			Expect(reconcile(interceptor.Funcs{})).To(Succeed())
+			Expect(importedIPs()).To(BeEmpty())
+		})
+
+		It("records a failure to import the IPs", func() {
+			Expect(reconcile(interceptor.Funcs{
+				Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+					return errors.New("boom")
+				},
+			})).ToNot(Succeed())
+			Expect(recorder.Events).To(Receive(HavePrefix("Warning " + eventReasonIPAssignmentFailed)))
+		})
+	})
+})
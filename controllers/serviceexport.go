@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+)
+
+// ServiceExportReconciler reconciles a ServiceExport object
+type ServiceExportReconciler struct {
+	client.Client
+	Log logr.Logger
+}
+
+// +kubebuilder:rbac:groups=multicluster.x-k8s.io,resources=serviceexports,verbs=get;list;watch
+// +kubebuilder:rbac:groups=multicluster.x-k8s.io,resources=serviceexports/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch
+
+// validCondition returns the Valid condition for an export of svc, or of a
+// missing Service when svc is nil.
+func validCondition(svc *v1.Service) metav1.Condition {
+	if svc == nil {
+		return v1beta1.NewServiceExportCondition(v1beta1.ServiceExportConditionValid, metav1.ConditionFalse,
+			v1beta1.ServiceExportReasonNoService, "Service not found")
+	}
+	if svc.Spec.Type == v1.ServiceTypeExternalName {
+		return v1beta1.NewServiceExportCondition(v1beta1.ServiceExportConditionValid, metav1.ConditionFalse,
+			v1beta1.ServiceExportReasonInvalidServiceType,
+			fmt.Sprintf("Service of type %s cannot be exported", v1.ServiceTypeExternalName))
+	}
+	return v1beta1.NewServiceExportCondition(v1beta1.ServiceExportConditionValid, metav1.ConditionTrue,
+		v1beta1.ServiceExportReasonValid, "Service is valid for export")
+}
+
+// Reconcile the changes.
+func (r *ServiceExportReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("serviceexport", req.NamespacedName)
+	var svcExport v1beta1.ServiceExport
+	if err := r.Client.Get(ctx, req.NamespacedName, &svcExport); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if svcExport.DeletionTimestamp != nil {
+		return ctrl.Result{}, nil
+	}
+
+	var svc *v1.Service
+	var service v1.Service
+	if err := r.Client.Get(ctx, req.NamespacedName, &service); err == nil {
+		svc = &service
+	} else if !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+
+	condition := validCondition(svc)
+	condition.ObservedGeneration = svcExport.Generation
+	if !meta.SetStatusCondition(&svcExport.Status.Conditions, condition) {
+		return ctrl.Result{}, nil
+	}
+	if err := r.Client.Status().Update(ctx, &svcExport); err != nil {
+		return ctrl.Result{}, err
+	}
+	log.Info("updated condition", "type", condition.Type, "status", condition.Status, "reason", condition.Reason)
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager wires up the controller.
+func (r *ServiceExportReconciler) SetupWithManager(mgr ctrl.Manager, opts controller.Options) error {
+	// A Service is exported by the ServiceExport of the same name, which only
+	// needs to be re-evaluated when the Service appears, disappears or
+	// changes type.
+	serviceTypeChanged := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldSvc, oldOK := e.ObjectOld.(*v1.Service)
+			newSvc, newOK := e.ObjectNew.(*v1.Service)
+			return !oldOK || !newOK || oldSvc.Spec.Type != newSvc.Spec.Type
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1beta1.ServiceExport{}).
+		WithOptions(opts).
+		Watches(&v1.Service{}, handler.EnqueueRequestsFromMapFunc(
+			func(_ context.Context, obj client.Object) []reconcile.Request {
+				return []reconcile.Request{{NamespacedName: client.ObjectKeyFromObject(obj)}}
+			}), builder.WithPredicates(serviceTypeChanged)).
+		Complete(countErrors("serviceexport", r))
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+)
+
+var _ = Describe("ServiceExport", func() {
+	var serviceName types.NamespacedName
+	ctx := context.Background()
+
+	validReason := func() v1beta1.ServiceExportConditionReason {
+		var svcExport v1beta1.ServiceExport
+		Expect(k8s.Get(ctx, serviceName, &svcExport)).To(Succeed())
+		condition := meta.FindStatusCondition(svcExport.Status.Conditions, string(v1beta1.ServiceExportConditionValid))
+		if condition == nil {
+			return ""
+		}
+		return v1beta1.ServiceExportConditionReason(condition.Reason)
+	}
+
+	createService := func(svcType v1.ServiceType) {
+		svc := v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: serviceName.Namespace,
+				Name:      serviceName.Name,
+			},
+			Spec: v1.ServiceSpec{
+				Type:  svcType,
+				Ports: []v1.ServicePort{{Port: 80}},
+			},
+		}
+		if svcType == v1.ServiceTypeExternalName {
+			svc.Spec.ExternalName = "example.com"
+			svc.Spec.Ports = nil
+		}
+		Expect(k8s.Create(ctx, &svc)).To(Succeed())
+	}
+
+	Context("validCondition", func() {
+		Specify("when the Service is missing", func() {
+			condition := validCondition(nil)
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(string(v1beta1.ServiceExportReasonNoService)))
+		})
+		Specify("when the Service is of type ExternalName", func() {
+			condition := validCondition(&v1.Service{Spec: v1.ServiceSpec{Type: v1.ServiceTypeExternalName}})
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(string(v1beta1.ServiceExportReasonInvalidServiceType)))
+		})
+		Specify("when the Service is of type ClusterIP", func() {
+			condition := validCondition(&v1.Service{Spec: v1.ServiceSpec{Type: v1.ServiceTypeClusterIP}})
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(string(v1beta1.ServiceExportReasonValid)))
+		})
+	})
+
+	Context("created", Label(envtestLabel), func() {
+		BeforeEach(func() {
+			serviceName = types.NamespacedName{Namespace: testNS, Name: fmt.Sprintf("svc-%v", rand.Uint64())}
+			Expect(k8s.Create(ctx, &v1beta1.ServiceExport{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: serviceName.Namespace,
+					Name:      serviceName.Name,
+				},
+			})).To(Succeed())
+		})
+		It("is invalid without a Service", func(ctx SpecContext) {
+			Eventually(ctx, validReason, 10).Should(Equal(v1beta1.ServiceExportReasonNoService))
+		}, SpecTimeout(15*time.Second))
+		It("becomes valid when the Service is created", func(ctx SpecContext) {
+			Eventually(ctx, validReason, 10).Should(Equal(v1beta1.ServiceExportReasonNoService))
+			createService(v1.ServiceTypeClusterIP)
+			Eventually(ctx, validReason, 10).Should(Equal(v1beta1.ServiceExportReasonValid))
+		}, SpecTimeout(25*time.Second))
+		It("is invalid when the Service is of type ExternalName", func(ctx SpecContext) {
+			createService(v1.ServiceTypeExternalName)
+			Eventually(ctx, validReason, 10).Should(Equal(v1beta1.ServiceExportReasonInvalidServiceType))
+		}, SpecTimeout(15*time.Second))
+		It("becomes invalid when the Service is deleted", func(ctx SpecContext) {
+			createService(v1.ServiceTypeClusterIP)
+			Eventually(ctx, validReason, 10).Should(Equal(v1beta1.ServiceExportReasonValid))
+			Expect(k8s.Delete(ctx, &v1.Service{ObjectMeta: metav1.ObjectMeta{
+				Namespace: serviceName.Namespace,
+				Name:      serviceName.Name,
+			}})).To(Succeed())
+			Eventually(ctx, validReason, 10).Should(Equal(v1beta1.ServiceExportReasonNoService))
+		}, SpecTimeout(25*time.Second))
+	})
+})
@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1/validation"
+)
+
+// ServiceExportValidationWebhookPath is the path on which the ServiceExport
+// validating webhook is served.
+const ServiceExportValidationWebhookPath = "/validate-multicluster-x-k8s-io-v1beta1-serviceexport"
+
+// reservedKeyDomains may not be used, nor any of their subdomains, as the
+// prefix of exported label and annotation keys.
+var reservedKeyDomains = []string{"multicluster.kubernetes.io", v1beta1.GroupName}
+
+// The webhook is only registered for v1beta1, the version the validator
+// decodes: with the Equivalent match policy, the API server converts v1alpha1
+// ServiceExports to v1beta1 before sending them to the webhook, so v1alpha1
+// requests are validated too. Listing v1alpha1 would instead send them
+// unconverted.
+// +kubebuilder:webhook:path=/validate-multicluster-x-k8s-io-v1beta1-serviceexport,mutating=false,failurePolicy=fail,sideEffects=None,matchPolicy=Equivalent,groups=multicluster.x-k8s.io,resources=serviceexports,verbs=create;update,versions=v1beta1,name=vserviceexport.multicluster.x-k8s.io,admissionReviewVersions=v1
+
+// ServiceExportValidator validates ServiceExports on admission. Creating an
+// export of an ExternalName Service is rejected, while updates of such an
+// export only produce a warning so that it can still be cleaned up.
+type ServiceExportValidator struct {
+	client.Client
+	Log logr.Logger
+}
+
+var _ admission.CustomValidator = &ServiceExportValidator{}
+
+// ValidateCreate validates a new ServiceExport.
+func (v *ServiceExportValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return v.validate(ctx, obj, true)
+}
+
+// ValidateUpdate validates an updated ServiceExport.
+func (v *ServiceExportValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return v.validate(ctx, newObj, false)
+}
+
+// ValidateDelete allows all deletions.
+func (v *ServiceExportValidator) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *ServiceExportValidator) validate(ctx context.Context, obj runtime.Object, create bool) (admission.Warnings, error) {
+	svcExport, ok := obj.(*v1beta1.ServiceExport)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a ServiceExport but got a %T", obj))
+	}
+
+	allErrs := validation.ValidateServiceExport(svcExport)
+	specPath := field.NewPath("spec")
+	allErrs = append(allErrs, validateReservedKeys(svcExport.Spec.ExportedLabels, specPath.Child("exportedLabels"))...)
+	allErrs = append(allErrs, validateReservedKeys(svcExport.Spec.ExportedAnnotations, specPath.Child("exportedAnnotations"))...)
+
+	var warnings admission.Warnings
+	var svc v1.Service
+	err := v.Get(ctx, types.NamespacedName{Namespace: svcExport.Namespace, Name: svcExport.Name}, &svc)
+	switch {
+	case apierrors.IsNotFound(err):
+	case err != nil:
+		// The controller reports invalid Services in the export's status, so
+		// don't block admission on a failed lookup.
+		v.Log.Error(err, "unable to get exported Service", "serviceexport", client.ObjectKeyFromObject(svcExport))
+	case svc.Spec.Type == v1.ServiceTypeExternalName:
+		msg := fmt.Sprintf("Service %q is of type %s, which cannot be exported", svc.Name, v1.ServiceTypeExternalName)
+		if create {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("metadata", "name"), msg))
+		} else {
+			warnings = append(warnings, msg)
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return warnings, apierrors.NewInvalid(schema.GroupKind{Group: v1beta1.GroupName, Kind: v1beta1.ServiceExportKindName},
+			svcExport.Name, allErrs)
+	}
+	return warnings, nil
+}
+
+func validateReservedKeys(m map[string]string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, key := range slices.Sorted(maps.Keys(m)) {
+		prefix, _, found := strings.Cut(key, "/")
+		if !found {
+			continue
+		}
+		for _, domain := range reservedKeyDomains {
+			if prefix == domain || strings.HasSuffix(prefix, "."+domain) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Key(key), key,
+					fmt.Sprintf("the %s/ prefix is reserved", domain)))
+				break
+			}
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+)
+
+var _ = Describe("ServiceExportValidator", func() {
+	var (
+		validator *ServiceExportValidator
+		svcExport *v1beta1.ServiceExport
+		ctx       = context.Background()
+	)
+
+	withServices := func(svcs ...*v1.Service) {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		builder := fake.NewClientBuilder().WithScheme(scheme)
+		for _, svc := range svcs {
+			builder = builder.WithObjects(svc)
+		}
+		validator = &ServiceExportValidator{Client: builder.Build(), Log: logr.Discard()}
+	}
+
+	service := func(svcType v1.ServiceType) *v1.Service {
+		return &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc"},
+			Spec:       v1.ServiceSpec{Type: svcType},
+		}
+	}
+
+	BeforeEach(func() {
+		withServices(service(v1.ServiceTypeClusterIP))
+		svcExport = &v1beta1.ServiceExport{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc"},
+			Spec: v1beta1.ServiceExportSpec{
+				ExportedLabels:      map[string]string{"app.kubernetes.io/name": "svc"},
+				ExportedAnnotations: map[string]string{"example.com/owner": "team-a"},
+			},
+		}
+	})
+
+	It("accepts a valid export", func() {
+		warnings, err := validator.ValidateCreate(ctx, svcExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(warnings).To(BeEmpty())
+	})
+
+	It("accepts an export of a missing Service", func() {
+		withServices()
+		_, err := validator.ValidateCreate(ctx, svcExport)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Context("when the Service is of type ExternalName", func() {
+		BeforeEach(func() {
+			withServices(service(v1.ServiceTypeExternalName))
+		})
+
+		It("rejects creating the export", func() {
+			_, err := validator.ValidateCreate(ctx, svcExport)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("ExternalName"))
+		})
+
+		It("warns when updating the export", func() {
+			warnings, err := validator.ValidateUpdate(ctx, svcExport, svcExport)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(ContainSubstring("ExternalName")))
+		})
+	})
+
+	It("rejects reserved exported label keys", func() {
+		svcExport.Spec.ExportedLabels[v1beta1.LabelServiceName] = "other"
+		_, err := validator.ValidateCreate(ctx, svcExport)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("spec.exportedLabels[" + v1beta1.LabelServiceName + "]"))
+	})
+
+	It("rejects reserved exported annotation keys", func() {
+		svcExport.Spec.ExportedAnnotations["foo.multicluster.x-k8s.io/bar"] = "baz"
+		_, err := validator.ValidateUpdate(ctx, svcExport, svcExport)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("prefix is reserved"))
+	})
+
+	It("rejects invalid exported label keys", func() {
+		svcExport.Spec.ExportedLabels["not a key"] = "value"
+		_, err := validator.ValidateCreate(ctx, svcExport)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+	})
+
+	It("allows deletion", func() {
+		withServices(service(v1.ServiceTypeExternalName))
+		_, err := validator.ValidateDelete(ctx, svcExport)
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
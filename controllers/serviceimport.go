@@ -18,24 +18,51 @@ package controllers
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
 )
 
+const (
+	eventReasonDerivedServiceCreated   = "DerivedServiceCreated"
+	eventReasonDerivedServiceUpdated   = "DerivedServiceUpdated"
+	eventReasonDerivedServiceRecreated = "DerivedServiceRecreated"
+	eventReasonDerivedServiceFailed    = "DerivedServiceFailed"
+
+	// serviceImportReasonNameCollision is used with the "Ready" condition when
+	// the name of the derived Service is taken by another Service.
+	serviceImportReasonNameCollision v1beta1.ServiceImportConditionReason = "NameCollision"
+)
+
 // ServiceImportReconciler reconciles a ServiceImport object
 type ServiceImportReconciler struct {
 	client.Client
-	Log logr.Logger
+	Log      logr.Logger
+	Recorder record.EventRecorder
+	Naming   NamingStrategy
 }
 
 // +kubebuilder:rbac:groups=multicluster.x-k8s.io,resources=serviceimports,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=multicluster.x-k8s.io,resources=serviceimports/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 func servicePorts(svcImport *v1beta1.ServiceImport) []v1.ServicePort {
 	ports := make([]v1.ServicePort, len(svcImport.Spec.Ports))
@@ -50,22 +77,178 @@ func servicePorts(svcImport *v1beta1.ServiceImport) []v1.ServicePort {
 	return ports
 }
 
+// portsMatch compares the ports of a Service with the desired ports, ignoring
+// the fields that aren't imported.
+func portsMatch(ports, desired []v1.ServicePort) bool {
+	protocol := func(p v1.ServicePort) v1.Protocol {
+		if p.Protocol == "" {
+			return v1.ProtocolTCP
+		}
+		return p.Protocol
+	}
+	return slices.EqualFunc(ports, desired, func(p, d v1.ServicePort) bool {
+		return p.Name == d.Name && protocol(p) == protocol(d) && p.Port == d.Port && ptr.Equal(p.AppProtocol, d.AppProtocol)
+	})
+}
+
+// ipFamilyPolicy returns the policy requesting the given IP families.
+func ipFamilyPolicy(families []v1.IPFamily) v1.IPFamilyPolicy {
+	if len(families) > 1 {
+		return v1.IPFamilyPolicyRequireDualStack
+	}
+	return v1.IPFamilyPolicySingleStack
+}
+
+// derivedServiceSpec returns the spec of the derived Service of a
+// ServiceImport. Fields that the ServiceImport leaves unset are set to the
+// defaults the API server would apply, so that comparing the spec with an
+// existing Service doesn't mistake defaulting for a change.
+func derivedServiceSpec(svcImport *v1beta1.ServiceImport) v1.ServiceSpec {
+	spec := v1.ServiceSpec{
+		Type:                  v1.ServiceTypeClusterIP,
+		Ports:                 servicePorts(svcImport),
+		SessionAffinity:       svcImport.Spec.SessionAffinity,
+		InternalTrafficPolicy: ptr.To(ptr.Deref(svcImport.Spec.InternalTrafficPolicy, v1.ServiceInternalTrafficPolicyCluster)),
+		TrafficDistribution:   svcImport.Spec.TrafficDistribution,
+	}
+	// A headless import is backed by the imported EndpointSlices directly, which
+	// cluster DNS serves as per-pod records of the headless derived Service.
+	if svcImport.Spec.Type == v1beta1.Headless {
+		spec.ClusterIP = v1.ClusterIPNone
+	}
+	if spec.SessionAffinity == "" {
+		spec.SessionAffinity = v1.ServiceAffinityNone
+	}
+	if spec.SessionAffinity == v1.ServiceAffinityClientIP {
+		spec.SessionAffinityConfig = svcImport.Spec.SessionAffinityConfig.DeepCopy()
+		if spec.SessionAffinityConfig == nil {
+			spec.SessionAffinityConfig = &v1.SessionAffinityConfig{
+				ClientIP: &v1.ClientIPConfig{TimeoutSeconds: ptr.To(v1.DefaultClientIPServiceAffinitySeconds)},
+			}
+		}
+	}
+	// Without imported IP families, the cluster's default family is used.
+	if len(svcImport.Spec.IPFamilies) > 0 {
+		spec.IPFamilies = slices.Clone(svcImport.Spec.IPFamilies)
+		spec.IPFamilyPolicy = ptr.To(ipFamilyPolicy(svcImport.Spec.IPFamilies))
+	}
+	return spec
+}
+
+// requiresRecreate reports whether the derived Service must be recreated to
+// match the ServiceImport, because the ServiceImport changes the headlessness
+// or the primary IP family of the Service, which can't be updated.
+func requiresRecreate(svc *v1.Service, svcImport *v1beta1.ServiceImport) bool {
+	if (svc.Spec.ClusterIP == v1.ClusterIPNone) != (svcImport.Spec.Type == v1beta1.Headless) {
+		return true
+	}
+	return len(svc.Spec.IPFamilies) > 0 && len(svcImport.Spec.IPFamilies) > 0 &&
+		svc.Spec.IPFamilies[0] != svcImport.Spec.IPFamilies[0]
+}
+
+// updateDerivedService updates the spec of the derived Service to match the
+// ServiceImport, and reports whether it changed.
+func updateDerivedService(svc *v1.Service, svcImport *v1beta1.ServiceImport) bool {
+	changed := false
+	desired := derivedServiceSpec(svcImport)
+
+	if !portsMatch(svc.Spec.Ports, desired.Ports) {
+		svc.Spec.Ports = desired.Ports
+		changed = true
+	}
+
+	if svc.Spec.SessionAffinity != desired.SessionAffinity ||
+		!equality.Semantic.DeepEqual(svc.Spec.SessionAffinityConfig, desired.SessionAffinityConfig) {
+		svc.Spec.SessionAffinity = desired.SessionAffinity
+		svc.Spec.SessionAffinityConfig = desired.SessionAffinityConfig
+		changed = true
+	}
+
+	if !ptr.Equal(svc.Spec.InternalTrafficPolicy, desired.InternalTrafficPolicy) {
+		svc.Spec.InternalTrafficPolicy = desired.InternalTrafficPolicy
+		changed = true
+	}
+
+	if !ptr.Equal(svc.Spec.TrafficDistribution, desired.TrafficDistribution) {
+		svc.Spec.TrafficDistribution = desired.TrafficDistribution
+		changed = true
+	}
+
+	if desired.IPFamilyPolicy != nil && (!slices.Equal(svc.Spec.IPFamilies, desired.IPFamilies) ||
+		!ptr.Equal(svc.Spec.IPFamilyPolicy, desired.IPFamilyPolicy)) {
+		svc.Spec.IPFamilies = desired.IPFamilies
+		svc.Spec.IPFamilyPolicy = desired.IPFamilyPolicy
+		// Dropping the secondary family requires dropping its cluster IP.
+		if len(svc.Spec.ClusterIPs) > len(desired.IPFamilies) {
+			svc.Spec.ClusterIPs = svc.Spec.ClusterIPs[:len(desired.IPFamilies)]
+		}
+		changed = true
+	}
+
+	return changed
+}
+
+// readyCondition returns the Ready condition of a ServiceImport whose derived
+// Service is up to date. A ClusterSetIP import is pending until the cluster IPs
+// of its derived Service are imported.
+func readyCondition(svcImport *v1beta1.ServiceImport) metav1.Condition {
+	if svcImport.Spec.Type == v1beta1.ClusterSetIP && len(svcImport.Spec.IPs) == 0 {
+		return v1beta1.NewServiceImportCondition(v1beta1.ServiceImportConditionReady, metav1.ConditionFalse,
+			v1beta1.ServiceImportReasonPending, "Waiting for the derived Service to be assigned a cluster IP")
+	}
+	return v1beta1.NewServiceImportCondition(v1beta1.ServiceImportConditionReady, metav1.ConditionTrue,
+		v1beta1.ServiceImportReasonReady, "Derived Service is ready")
+}
+
+// isIPFamilyError reports whether the API server rejected a Service because
+// its IP families or IP family policy aren't supported by the cluster.
+func isIPFamilyError(err error) bool {
+	var status apierrors.APIStatus
+	if !apierrors.IsInvalid(err) || !errors.As(err, &status) || status.Status().Details == nil {
+		return false
+	}
+	for _, cause := range status.Status().Details.Causes {
+		if strings.HasPrefix(cause.Field, "spec.ipFamilies") || strings.HasPrefix(cause.Field, "spec.ipFamilyPolicy") {
+			return true
+		}
+	}
+	return false
+}
+
+// setControllerRef marks the owner reference of the ServiceImport on its
+// derived Service as the controller reference, and reports whether it changed.
+// A reference matching the ServiceImport by name only, left by a deleted
+// ServiceImport of the same name, is replaced by one with the current UID.
+func setControllerRef(svc *v1.Service, svcImport *v1beta1.ServiceImport) bool {
+	for i, ref := range svc.OwnerReferences {
+		if ref.APIVersion == v1beta1.GroupVersion.String() && ref.Kind == serviceImportKind && ref.Name == svcImport.Name {
+			if ref.UID == svcImport.UID && ptr.Deref(ref.Controller, false) {
+				return false
+			}
+			svc.OwnerReferences[i].UID = svcImport.UID
+			svc.OwnerReferences[i].Controller = ptr.To(true)
+			return true
+		}
+	}
+	return false
+}
+
 func shouldIgnoreImport(svcImport *v1beta1.ServiceImport) bool {
 	if svcImport.DeletionTimestamp != nil {
 		return true
 	}
-	if svcImport.Spec.Type != v1beta1.ClusterSetIP {
+	if svcImport.Spec.Type != v1beta1.ClusterSetIP && svcImport.Spec.Type != v1beta1.Headless {
 		return true
 	}
 	return false
 }
 
-// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=services/status,verbs=get;list;watch;create;update;patch
 
 // Reconcile the changes.
 func (r *ServiceImportReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	serviceName := derivedName(req.NamespacedName)
+	serviceName := r.Naming.DerivedName(req.NamespacedName)
 	log := r.Log.WithValues("serviceimport", req.NamespacedName, "derived", serviceName)
 	var svcImport v1beta1.ServiceImport
 	if err := r.Client.Get(ctx, req.NamespacedName, &svcImport); err != nil {
@@ -81,15 +264,48 @@ func (r *ServiceImportReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		if svcImport.Annotations == nil {
 			svcImport.Annotations = map[string]string{}
 		}
-		svcImport.Annotations[DerivedServiceAnnotation] = derivedName(req.NamespacedName)
+		svcImport.Annotations[DerivedServiceAnnotation] = serviceName
 		if err := r.Client.Update(ctx, &svcImport); err != nil {
 			return ctrl.Result{}, err
 		}
 		log.Info("added annotation", DerivedServiceAnnotation, svcImport.Annotations[DerivedServiceAnnotation])
-		return ctrl.Result{}, nil
+		return ctrl.Result{}, r.setReadyCondition(ctx, &svcImport, v1beta1.NewServiceImportCondition(
+			v1beta1.ServiceImportConditionReady, metav1.ConditionFalse, v1beta1.ServiceImportReasonPending,
+			"Creating the derived Service"))
 	}
 	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: svcImport.Annotations[DerivedServiceAnnotation]}, &svc); err == nil {
-		return ctrl.Result{}, nil
+		if serviceImportOwner(svc.OwnerReferences) != svcImport.Name {
+			log.Info("derived service name taken by another service", "service", svc.Name)
+			r.Recorder.Eventf(&svcImport, v1.EventTypeWarning, string(serviceImportReasonNameCollision),
+				"Service %s is not derived from this ServiceImport", svc.Name)
+			return ctrl.Result{}, r.setReadyCondition(ctx, &svcImport, v1beta1.NewServiceImportCondition(
+				v1beta1.ServiceImportConditionReady, metav1.ConditionFalse, serviceImportReasonNameCollision,
+				fmt.Sprintf("The name of the derived Service is taken by Service %s", svc.Name)))
+		}
+		if requiresRecreate(&svc, &svcImport) {
+			// The Service is created again from the new spec once deleted.
+			if err := r.Client.Delete(ctx, &svc); client.IgnoreNotFound(err) != nil {
+				return ctrl.Result{}, r.derivedServiceError(ctx, &svcImport, "delete", err)
+			}
+			log.Info("deleted service to recreate it", "type", svcImport.Spec.Type, "ipFamilies", svcImport.Spec.IPFamilies)
+			r.Recorder.Eventf(&svcImport, v1.EventTypeNormal, eventReasonDerivedServiceRecreated,
+				"Deleted derived Service %s to recreate it", svc.Name)
+			return ctrl.Result{Requeue: true}, r.setReadyCondition(ctx, &svcImport, v1beta1.NewServiceImportCondition(
+				v1beta1.ServiceImportConditionReady, metav1.ConditionFalse, v1beta1.ServiceImportReasonPending,
+				"Recreating the derived Service"))
+		}
+		// Derived Services created before the ServiceImport was made their
+		// controller are adopted so that their changes are watched.
+		adopted := setControllerRef(&svc, &svcImport)
+		if updateDerivedService(&svc, &svcImport) || adopted {
+			if err := r.Client.Update(ctx, &svc); err != nil {
+				return ctrl.Result{}, r.derivedServiceError(ctx, &svcImport, "update", err)
+			}
+			log.Info("updated service")
+			r.Recorder.Eventf(&svcImport, v1.EventTypeNormal, eventReasonDerivedServiceUpdated,
+				"Updated derived Service %s", svc.Name)
+		}
+		return ctrl.Result{}, r.setReadyCondition(ctx, &svcImport, readyCondition(&svcImport))
 	} else if !apierrors.IsNotFound(err) {
 		return ctrl.Result{}, err
 	}
@@ -104,21 +320,21 @@ func (r *ServiceImportReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 					Kind:       serviceImportKind,
 					APIVersion: v1beta1.GroupVersion.String(),
 					UID:        svcImport.UID,
+					Controller: ptr.To(true),
 				},
 			},
 		},
-		Spec: v1.ServiceSpec{
-			Type:  v1.ServiceTypeClusterIP,
-			Ports: servicePorts(&svcImport),
-		},
+		Spec: derivedServiceSpec(&svcImport),
 	}
 	if err := r.Client.Create(ctx, &svc); err != nil {
-		return ctrl.Result{}, err
+		return ctrl.Result{}, r.derivedServiceError(ctx, &svcImport, "create", err)
 	}
 	log.Info("created service")
+	r.Recorder.Eventf(&svcImport, v1.EventTypeNormal, eventReasonDerivedServiceCreated,
+		"Created derived Service %s", svc.Name)
 
 	if len(svcImport.Spec.IPs) == 0 {
-		return ctrl.Result{}, nil
+		return ctrl.Result{}, r.setReadyCondition(ctx, &svcImport, readyCondition(&svcImport))
 	}
 
 	// update loadbalanacer status with provided clustersetIPs
@@ -136,13 +352,57 @@ func (r *ServiceImportReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	}
 
 	if err := r.Client.Status().Update(ctx, &svc); err != nil {
-		return ctrl.Result{}, err
+		return ctrl.Result{}, r.derivedServiceError(ctx, &svcImport, "update the status of", err)
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{}, r.setReadyCondition(ctx, &svcImport, readyCondition(&svcImport))
 }
 
-// SetupWithManager wires up the controller.
-func (r *ServiceImportReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).For(&v1beta1.ServiceImport{}).Complete(r)
+// setReadyCondition sets the Ready condition of the ServiceImport, updating its
+// status if the condition changed.
+func (r *ServiceImportReconciler) setReadyCondition(ctx context.Context, svcImport *v1beta1.ServiceImport, condition metav1.Condition) error {
+	condition.ObservedGeneration = svcImport.Generation
+	if !meta.SetStatusCondition(&svcImport.Status.Conditions, condition) {
+		return nil
+	}
+	return r.Client.Status().Update(ctx, svcImport)
+}
+
+// derivedServiceError records the failure to write the derived Service of the
+// ServiceImport and returns the error to retry. An IP family the cluster
+// doesn't support is reported in the Ready condition instead, since retrying
+// can't succeed until the ServiceImport changes.
+func (r *ServiceImportReconciler) derivedServiceError(ctx context.Context, svcImport *v1beta1.ServiceImport, action string, err error) error {
+	if isIPFamilyError(err) {
+		r.Recorder.Eventf(svcImport, v1.EventTypeWarning, string(v1beta1.ServiceImportReasonIPFamilyNotSupported),
+			"Failed to %s derived Service: %v", action, err)
+		return r.setReadyCondition(ctx, svcImport, v1beta1.NewServiceImportCondition(
+			v1beta1.ServiceImportConditionReady, metav1.ConditionFalse, v1beta1.ServiceImportReasonIPFamilyNotSupported,
+			fmt.Sprintf("IP families %v are not supported by the cluster", svcImport.Spec.IPFamilies)))
+	}
+	r.Recorder.Eventf(svcImport, v1.EventTypeWarning, eventReasonDerivedServiceFailed,
+		"Failed to %s derived Service: %v", action, err)
+	return err
+}
+
+// SetupWithManager wires up the controller. Changes to derived Services and
+// imported EndpointSlices reconcile their ServiceImport, so that a derived
+// Service that is deleted or modified is repaired.
+func (r *ServiceImportReconciler) SetupWithManager(mgr ctrl.Manager, opts controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1beta1.ServiceImport{}).
+		Owns(&v1.Service{}).
+		Watches(&discoveryv1.EndpointSlice{}, handler.EnqueueRequestsFromMapFunc(endpointSliceToServiceImport)).
+		WithOptions(opts).
+		Complete(countErrors("serviceimport", r))
+}
+
+// endpointSliceToServiceImport maps an imported EndpointSlice to its
+// ServiceImport.
+func endpointSliceToServiceImport(_ context.Context, obj client.Object) []reconcile.Request {
+	name := obj.GetLabels()[v1beta1.LabelServiceName]
+	if name == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: name}}}
 }
@@ -22,11 +22,24 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
 )
 
@@ -37,7 +50,7 @@ var _ = Describe("ServiceImport", func() {
 		derivedServiceName types.NamespacedName
 	)
 	ctx := context.Background()
-	Context("should be ignored", func() {
+	Context("should not be ignored", func() {
 		Specify("when headless", func() {
 			Expect(shouldIgnoreImport(&v1beta1.ServiceImport{
 				ObjectMeta: metav1.ObjectMeta{
@@ -50,6 +63,22 @@ var _ = Describe("ServiceImport", func() {
 						{Port: 80},
 					},
 				},
+			})).To(BeFalse())
+		})
+	})
+	Context("should be ignored", func() {
+		Specify("when of an unknown type", func() {
+			Expect(shouldIgnoreImport(&v1beta1.ServiceImport{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testNS,
+					Name:      "unknown",
+				},
+				Spec: v1beta1.ServiceImportSpec{
+					Type: "LoadBalancer",
+					Ports: []v1beta1.ServicePort{
+						{Port: 80},
+					},
+				},
 			})).To(BeTrue())
 		})
 		Specify("when deleted", func() {
@@ -68,7 +97,394 @@ var _ = Describe("ServiceImport", func() {
 			})).To(BeTrue())
 		})
 	})
-	Context("created", func() {
+	Context("updateDerivedService", func() {
+		var (
+			svcImport *v1beta1.ServiceImport
+			svc       *v1.Service
+		)
+		BeforeEach(func() {
+			svcImport = &v1beta1.ServiceImport{
+				Spec: v1beta1.ServiceImportSpec{
+					Type:  v1beta1.ClusterSetIP,
+					Ports: []v1beta1.ServicePort{{Name: "http", Port: 80}},
+				},
+			}
+			// The derived Service as defaulted by the API server.
+			svc = &v1.Service{
+				Spec: v1.ServiceSpec{
+					Type:                  v1.ServiceTypeClusterIP,
+					Ports:                 []v1.ServicePort{{Name: "http", Protocol: v1.ProtocolTCP, Port: 80, TargetPort: intstr.FromInt32(80)}},
+					SessionAffinity:       v1.ServiceAffinityNone,
+					InternalTrafficPolicy: ptr.To(v1.ServiceInternalTrafficPolicyCluster),
+					IPFamilies:            []v1.IPFamily{v1.IPv4Protocol},
+					IPFamilyPolicy:        ptr.To(v1.IPFamilyPolicySingleStack),
+				},
+			}
+		})
+		It("leaves a matching Service unchanged", func() {
+			Expect(updateDerivedService(svc, svcImport)).To(BeFalse())
+		})
+		It("updates the ports", func() {
+			svcImport.Spec.Ports = append(svcImport.Spec.Ports, v1beta1.ServicePort{Name: "dns", Protocol: v1.ProtocolUDP, Port: 53})
+			Expect(updateDerivedService(svc, svcImport)).To(BeTrue())
+			Expect(svc.Spec.Ports).To(Equal(servicePorts(svcImport)))
+			Expect(updateDerivedService(svc, svcImport)).To(BeFalse())
+		})
+		It("updates the session affinity", func() {
+			svcImport.Spec.SessionAffinity = v1.ServiceAffinityClientIP
+			svcImport.Spec.SessionAffinityConfig = &v1.SessionAffinityConfig{ClientIP: &v1.ClientIPConfig{TimeoutSeconds: ptr.To[int32](10)}}
+			Expect(updateDerivedService(svc, svcImport)).To(BeTrue())
+			Expect(svc.Spec.SessionAffinity).To(Equal(v1.ServiceAffinityClientIP))
+			Expect(svc.Spec.SessionAffinityConfig).To(Equal(svcImport.Spec.SessionAffinityConfig))
+
+			svcImport.Spec.SessionAffinity = v1.ServiceAffinityNone
+			svcImport.Spec.SessionAffinityConfig = nil
+			Expect(updateDerivedService(svc, svcImport)).To(BeTrue())
+			Expect(svc.Spec.SessionAffinity).To(Equal(v1.ServiceAffinityNone))
+			Expect(svc.Spec.SessionAffinityConfig).To(BeNil())
+		})
+		It("updates the traffic policies", func() {
+			svcImport.Spec.InternalTrafficPolicy = ptr.To(v1.ServiceInternalTrafficPolicyLocal)
+			svcImport.Spec.TrafficDistribution = ptr.To(v1.ServiceTrafficDistributionPreferClose)
+			Expect(updateDerivedService(svc, svcImport)).To(BeTrue())
+			Expect(svc.Spec.InternalTrafficPolicy).To(Equal(svcImport.Spec.InternalTrafficPolicy))
+			Expect(svc.Spec.TrafficDistribution).To(Equal(svcImport.Spec.TrafficDistribution))
+		})
+		It("updates the IP families", func() {
+			svcImport.Spec.IPFamilies = []v1.IPFamily{v1.IPv4Protocol, v1.IPv6Protocol}
+			Expect(updateDerivedService(svc, svcImport)).To(BeTrue())
+			Expect(svc.Spec.IPFamilies).To(Equal(svcImport.Spec.IPFamilies))
+			Expect(svc.Spec.IPFamilyPolicy).To(Equal(ptr.To(v1.IPFamilyPolicyRequireDualStack)))
+		})
+		It("drops the secondary IP family", func() {
+			svc.Spec.IPFamilies = []v1.IPFamily{v1.IPv4Protocol, v1.IPv6Protocol}
+			svc.Spec.IPFamilyPolicy = ptr.To(v1.IPFamilyPolicyRequireDualStack)
+			svc.Spec.ClusterIPs = []string{"10.0.0.1", "fd00::1"}
+			svcImport.Spec.IPFamilies = []v1.IPFamily{v1.IPv4Protocol}
+			Expect(updateDerivedService(svc, svcImport)).To(BeTrue())
+			Expect(svc.Spec.IPFamilies).To(Equal(svcImport.Spec.IPFamilies))
+			Expect(svc.Spec.IPFamilyPolicy).To(Equal(ptr.To(v1.IPFamilyPolicySingleStack)))
+			Expect(svc.Spec.ClusterIPs).To(Equal([]string{"10.0.0.1"}))
+		})
+	})
+	Context("derivedServiceSpec", func() {
+		It("maps the ServiceImport spec", func() {
+			spec := derivedServiceSpec(&v1beta1.ServiceImport{
+				Spec: v1beta1.ServiceImportSpec{
+					Type:                  v1beta1.ClusterSetIP,
+					Ports:                 []v1beta1.ServicePort{{Name: "http", Protocol: v1.ProtocolTCP, Port: 80}},
+					SessionAffinity:       v1.ServiceAffinityClientIP,
+					SessionAffinityConfig: &v1.SessionAffinityConfig{ClientIP: &v1.ClientIPConfig{TimeoutSeconds: ptr.To[int32](10)}},
+					IPFamilies:            []v1.IPFamily{v1.IPv6Protocol, v1.IPv4Protocol},
+					InternalTrafficPolicy: ptr.To(v1.ServiceInternalTrafficPolicyLocal),
+					TrafficDistribution:   ptr.To(v1.ServiceTrafficDistributionPreferClose),
+				},
+			})
+			Expect(spec).To(Equal(v1.ServiceSpec{
+				Type:                  v1.ServiceTypeClusterIP,
+				Ports:                 []v1.ServicePort{{Name: "http", Protocol: v1.ProtocolTCP, Port: 80}},
+				SessionAffinity:       v1.ServiceAffinityClientIP,
+				SessionAffinityConfig: &v1.SessionAffinityConfig{ClientIP: &v1.ClientIPConfig{TimeoutSeconds: ptr.To[int32](10)}},
+				IPFamilies:            []v1.IPFamily{v1.IPv6Protocol, v1.IPv4Protocol},
+				IPFamilyPolicy:        ptr.To(v1.IPFamilyPolicyRequireDualStack),
+				InternalTrafficPolicy: ptr.To(v1.ServiceInternalTrafficPolicyLocal),
+				TrafficDistribution:   ptr.To(v1.ServiceTrafficDistributionPreferClose),
+			}))
+		})
+		It("requests a single stack for one IP family", func() {
+			spec := derivedServiceSpec(&v1beta1.ServiceImport{
+				Spec: v1beta1.ServiceImportSpec{IPFamilies: []v1.IPFamily{v1.IPv6Protocol}},
+			})
+			Expect(spec.IPFamilyPolicy).To(Equal(ptr.To(v1.IPFamilyPolicySingleStack)))
+		})
+		It("leaves the IP families to the cluster when none are imported", func() {
+			spec := derivedServiceSpec(&v1beta1.ServiceImport{})
+			Expect(spec.IPFamilies).To(BeEmpty())
+			Expect(spec.IPFamilyPolicy).To(BeNil())
+		})
+		It("creates a headless Service for a headless import", func() {
+			spec := derivedServiceSpec(&v1beta1.ServiceImport{
+				Spec: v1beta1.ServiceImportSpec{Type: v1beta1.Headless},
+			})
+			Expect(spec.Type).To(Equal(v1.ServiceTypeClusterIP))
+			Expect(spec.ClusterIP).To(Equal(v1.ClusterIPNone))
+		})
+		It("defaults the session affinity config for ClientIP", func() {
+			spec := derivedServiceSpec(&v1beta1.ServiceImport{
+				Spec: v1beta1.ServiceImportSpec{SessionAffinity: v1.ServiceAffinityClientIP},
+			})
+			Expect(spec.SessionAffinityConfig.ClientIP.TimeoutSeconds).To(Equal(ptr.To(v1.DefaultClientIPServiceAffinitySeconds)))
+		})
+	})
+	Context("requiresRecreate", func() {
+		svc := &v1.Service{Spec: v1.ServiceSpec{ClusterIP: "10.0.0.1", IPFamilies: []v1.IPFamily{v1.IPv4Protocol}}}
+		Specify("when the primary family differs", func() {
+			Expect(requiresRecreate(svc, &v1beta1.ServiceImport{
+				Spec: v1beta1.ServiceImportSpec{Type: v1beta1.ClusterSetIP, IPFamilies: []v1.IPFamily{v1.IPv6Protocol, v1.IPv4Protocol}},
+			})).To(BeTrue())
+		})
+		Specify("when the import becomes headless", func() {
+			Expect(requiresRecreate(svc, &v1beta1.ServiceImport{
+				Spec: v1beta1.ServiceImportSpec{Type: v1beta1.Headless},
+			})).To(BeTrue())
+		})
+		Specify("when the import is no longer headless", func() {
+			Expect(requiresRecreate(&v1.Service{Spec: v1.ServiceSpec{ClusterIP: v1.ClusterIPNone}}, &v1beta1.ServiceImport{
+				Spec: v1beta1.ServiceImportSpec{Type: v1beta1.ClusterSetIP},
+			})).To(BeTrue())
+		})
+		Specify("not when a secondary family is added", func() {
+			Expect(requiresRecreate(svc, &v1beta1.ServiceImport{
+				Spec: v1beta1.ServiceImportSpec{Type: v1beta1.ClusterSetIP, IPFamilies: []v1.IPFamily{v1.IPv4Protocol, v1.IPv6Protocol}},
+			})).To(BeFalse())
+		})
+		Specify("not when no families are imported", func() {
+			Expect(requiresRecreate(svc, &v1beta1.ServiceImport{
+				Spec: v1beta1.ServiceImportSpec{Type: v1beta1.ClusterSetIP},
+			})).To(BeFalse())
+		})
+	})
+	Context("setControllerRef", func() {
+		svcImport := &v1beta1.ServiceImport{ObjectMeta: metav1.ObjectMeta{Name: "svc", UID: "uid"}}
+		owner := metav1.OwnerReference{APIVersion: v1beta1.GroupVersion.String(), Kind: serviceImportKind, Name: "svc", UID: "uid"}
+		It("marks the ServiceImport as controller", func() {
+			svc := &v1.Service{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{owner}}}
+			Expect(setControllerRef(svc, svcImport)).To(BeTrue())
+			Expect(svc.OwnerReferences[0].Controller).To(Equal(ptr.To(true)))
+			Expect(setControllerRef(svc, svcImport)).To(BeFalse())
+		})
+		It("replaces the reference to a previous ServiceImport of the same name", func() {
+			stale := owner
+			stale.UID = "old-uid"
+			stale.Controller = ptr.To(true)
+			svc := &v1.Service{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{stale}}}
+			Expect(setControllerRef(svc, svcImport)).To(BeTrue())
+			Expect(svc.OwnerReferences).To(ConsistOf(metav1.OwnerReference{
+				APIVersion: owner.APIVersion, Kind: owner.Kind, Name: "svc", UID: "uid", Controller: ptr.To(true),
+			}))
+		})
+		It("ignores other owners", func() {
+			svc := &v1.Service{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{owner}}}
+			Expect(setControllerRef(svc, &v1beta1.ServiceImport{ObjectMeta: metav1.ObjectMeta{Name: "other"}})).To(BeFalse())
+			Expect(svc.OwnerReferences[0].Controller).To(BeNil())
+		})
+	})
+	Context("endpointSliceToServiceImport", func() {
+		It("maps an imported EndpointSlice to its ServiceImport", func() {
+			Expect(endpointSliceToServiceImport(ctx, &discoveryv1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "slice", Labels: map[string]string{v1beta1.LabelServiceName: "svc"}},
+			})).To(Equal([]reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "svc"}}}))
+		})
+		It("ignores other EndpointSlices", func() {
+			Expect(endpointSliceToServiceImport(ctx, &discoveryv1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "slice"},
+			})).To(BeEmpty())
+		})
+	})
+	Context("readyCondition", func() {
+		It("is pending until a ClusterSetIP import has IPs", func() {
+			condition := readyCondition(&v1beta1.ServiceImport{Spec: v1beta1.ServiceImportSpec{Type: v1beta1.ClusterSetIP}})
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(string(v1beta1.ServiceImportReasonPending)))
+		})
+		It("is ready once a ClusterSetIP import has IPs", func() {
+			condition := readyCondition(&v1beta1.ServiceImport{
+				Spec: v1beta1.ServiceImportSpec{Type: v1beta1.ClusterSetIP, IPs: []string{"10.42.42.42"}},
+			})
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(string(v1beta1.ServiceImportReasonReady)))
+		})
+		It("is ready for a headless import", func() {
+			condition := readyCondition(&v1beta1.ServiceImport{Spec: v1beta1.ServiceImportSpec{Type: v1beta1.Headless}})
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		})
+	})
+	Context("isIPFamilyError", func() {
+		invalid := func(path *field.Path) error {
+			return apierrors.NewInvalid(schema.GroupKind{Kind: "Service"}, "svc",
+				field.ErrorList{field.Invalid(path, "IPv6", "not configured on this cluster")})
+		}
+		Specify("for an invalid IP family", func() {
+			Expect(isIPFamilyError(invalid(field.NewPath("spec", "ipFamilies").Index(0)))).To(BeTrue())
+		})
+		Specify("for an invalid IP family policy", func() {
+			Expect(isIPFamilyError(invalid(field.NewPath("spec", "ipFamilyPolicy")))).To(BeTrue())
+		})
+		Specify("not for other errors", func() {
+			Expect(isIPFamilyError(invalid(field.NewPath("spec", "ports")))).To(BeFalse())
+			Expect(isIPFamilyError(apierrors.NewConflict(schema.GroupResource{Resource: "services"}, "svc", nil))).To(BeFalse())
+		})
+	})
+	Context("reconciled", func() {
+		var (
+			reconciler *ServiceImportReconciler
+			recorder   *record.FakeRecorder
+			svcImport  *v1beta1.ServiceImport
+		)
+		importName := types.NamespacedName{Namespace: "ns", Name: "svc"}
+
+		build := func(funcs interceptor.Funcs) {
+			recorder = record.NewFakeRecorder(10)
+			reconciler = &ServiceImportReconciler{
+				Client:   newFakeClient(funcs, svcImport),
+				Log:      logr.Discard(),
+				Recorder: recorder,
+				Naming:   HashNamingStrategy{},
+			}
+		}
+
+		reconcile := func() error {
+			_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: importName})
+			return err
+		}
+
+		ready := func() *metav1.Condition {
+			Expect(reconciler.Client.Get(ctx, importName, svcImport)).To(Succeed())
+			return meta.FindStatusCondition(svcImport.Status.Conditions, string(v1beta1.ServiceImportConditionReady))
+		}
+
+		BeforeEach(func() {
+			svcImport = &v1beta1.ServiceImport{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   importName.Namespace,
+					Name:        importName.Name,
+					Annotations: map[string]string{DerivedServiceAnnotation: derivedName(importName)},
+				},
+				Spec: v1beta1.ServiceImportSpec{
+					Type:  v1beta1.ClusterSetIP,
+					Ports: []v1beta1.ServicePort{{Port: 80}},
+				},
+			}
+		})
+
+		derivedService := func() *v1.Service {
+			var svc v1.Service
+			Expect(reconciler.Client.Get(ctx, types.NamespacedName{Namespace: importName.Namespace, Name: derivedName(importName)}, &svc)).To(Succeed())
+			return &svc
+		}
+
+		It("annotates the ServiceImport with the name of its derived Service first", func() {
+			svcImport.Annotations = nil
+			build(allocateClusterIPs())
+			Expect(reconcile()).To(Succeed())
+			Expect(ready().Reason).To(Equal(string(v1beta1.ServiceImportReasonPending)))
+			Expect(svcImport.Annotations).To(HaveKeyWithValue(DerivedServiceAnnotation, derivedName(importName)))
+
+			var services v1.ServiceList
+			Expect(reconciler.Client.List(ctx, &services)).To(Succeed())
+			Expect(services.Items).To(BeEmpty())
+		})
+
+		It("creates the derived Service", func() {
+			build(allocateClusterIPs())
+			Expect(reconcile()).To(Succeed())
+
+			svc := derivedService()
+			Expect(svc.OwnerReferences).To(ConsistOf(HaveField("Name", importName.Name)))
+			Expect(svc.OwnerReferences[0].Controller).To(Equal(ptr.To(true)))
+			Expect(svc.Spec.Ports).To(Equal(servicePorts(svcImport)))
+			Expect(svc.Spec.ClusterIP).ToNot(BeEmpty())
+			Expect(svc.Status.LoadBalancer.Ingress).To(BeEmpty())
+		})
+
+		It("sets the load balancer status of the derived Service to the imported IPs", func() {
+			svcImport.Spec.IPs = []string{"10.42.42.42"}
+			build(allocateClusterIPs())
+			Expect(reconcile()).To(Succeed())
+			Expect(derivedService().Status.LoadBalancer.Ingress).To(Equal([]v1.LoadBalancerIngress{{IP: "10.42.42.42"}}))
+			Expect(ready().Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("becomes ready once the cluster IP of the derived Service is imported", func() {
+			build(allocateClusterIPs())
+			Expect(reconcile()).To(Succeed())
+			Expect(ready().Reason).To(Equal(string(v1beta1.ServiceImportReasonPending)))
+
+			svc := derivedService()
+			_, err := (&ServiceReconciler{Client: reconciler.Client, Log: logr.Discard(), Recorder: recorder}).
+				Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(svc)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reconcile()).To(Succeed())
+			Expect(ready().Status).To(Equal(metav1.ConditionTrue))
+			Expect(svcImport.Spec.IPs).To(Equal(svc.Spec.ClusterIPs))
+		})
+
+		It("records the creation and is pending until the IPs are imported", func() {
+			build(allocateClusterIPs())
+			Expect(reconcile()).To(Succeed())
+			Expect(<-recorder.Events).To(ContainSubstring(eventReasonDerivedServiceCreated))
+			Expect(ready().Reason).To(Equal(string(v1beta1.ServiceImportReasonPending)))
+
+			svcImport.Spec.IPs = []string{"10.42.42.42"}
+			Expect(reconciler.Client.Update(ctx, svcImport)).To(Succeed())
+			Expect(reconcile()).To(Succeed())
+			Expect(ready().Status).To(Equal(metav1.ConditionTrue))
+			Expect(ready().Reason).To(Equal(string(v1beta1.ServiceImportReasonReady)))
+		})
+
+		It("reports unsupported IP families without retrying", func() {
+			svcImport.Spec.IPFamilies = []v1.IPFamily{v1.IPv6Protocol}
+			build(interceptor.Funcs{
+				Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+					return apierrors.NewInvalid(schema.GroupKind{Kind: "Service"}, obj.GetName(), field.ErrorList{
+						field.Invalid(field.NewPath("spec", "ipFamilies").Index(0), v1.IPv6Protocol, "not configured on this cluster"),
+					})
+				},
+			})
+			Expect(reconcile()).To(Succeed())
+			Expect(<-recorder.Events).To(ContainSubstring("Warning " + string(v1beta1.ServiceImportReasonIPFamilyNotSupported)))
+			Expect(ready().Status).To(Equal(metav1.ConditionFalse))
+			Expect(ready().Reason).To(Equal(string(v1beta1.ServiceImportReasonIPFamilyNotSupported)))
+		})
+
+		It("reports a derived Service name taken by another Service", func() {
+			build(interceptor.Funcs{})
+			Expect(reconciler.Client.Create(ctx, &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: importName.Namespace, Name: derivedName(importName)},
+			})).To(Succeed())
+			Expect(reconcile()).To(Succeed())
+			Expect(<-recorder.Events).To(ContainSubstring("Warning " + string(serviceImportReasonNameCollision)))
+			Expect(ready().Reason).To(Equal(string(serviceImportReasonNameCollision)))
+
+			var svc v1.Service
+			Expect(reconciler.Client.Get(ctx, types.NamespacedName{Namespace: importName.Namespace, Name: derivedName(importName)}, &svc)).To(Succeed())
+			Expect(svc.OwnerReferences).To(BeEmpty())
+			Expect(svc.Spec.Ports).To(BeEmpty())
+		})
+
+		It("adopts a derived Service without a controller reference", func() {
+			build(interceptor.Funcs{})
+			svc := &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: importName.Namespace,
+					Name:      derivedName(importName),
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: v1beta1.GroupVersion.String(),
+						Kind:       serviceImportKind,
+						Name:       importName.Name,
+					}},
+				},
+				Spec: derivedServiceSpec(svcImport),
+			}
+			Expect(reconciler.Client.Create(ctx, svc)).To(Succeed())
+			Expect(reconcile()).To(Succeed())
+
+			Expect(reconciler.Client.Get(ctx, client.ObjectKeyFromObject(svc), svc)).To(Succeed())
+			Expect(svc.OwnerReferences[0].Controller).To(Equal(ptr.To(true)))
+		})
+
+		It("records other failures and retries", func() {
+			build(interceptor.Funcs{
+				Create: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.CreateOption) error {
+					return apierrors.NewServiceUnavailable("unavailable")
+				},
+			})
+			Expect(reconcile()).ToNot(Succeed())
+			Expect(<-recorder.Events).To(ContainSubstring("Warning " + eventReasonDerivedServiceFailed))
+			Expect(ready()).To(BeNil())
+		})
+	})
+	Context("created", Label(envtestLabel), func() {
 		BeforeEach(func() {
 			serviceName = types.NamespacedName{Namespace: testNS, Name: fmt.Sprintf("svc-%v", rand.Uint64())}
 			derivedServiceName = types.NamespacedName{Namespace: testNS, Name: derivedName(serviceName)}
@@ -92,7 +508,14 @@ var _ = Describe("ServiceImport", func() {
 				Expect(k8s.Get(ctx, serviceName, &s)).To(Succeed())
 				return s.Annotations[DerivedServiceAnnotation]
 			}, 10).Should(Equal(derivedName(serviceName)))
-		}, 10)
+		})
+		It("becomes ready", func() {
+			Eventually(func() *metav1.Condition {
+				var s v1beta1.ServiceImport
+				Expect(k8s.Get(ctx, serviceName, &s)).To(Succeed())
+				return meta.FindStatusCondition(s.Status.Conditions, string(v1beta1.ServiceImportConditionReady))
+			}, 10).Should(And(Not(BeNil()), HaveField("Status", metav1.ConditionTrue)))
+		})
 		It("has derived service IP", func() {
 			var s v1beta1.ServiceImport
 			Eventually(func() string {
@@ -102,7 +525,7 @@ var _ = Describe("ServiceImport", func() {
 				}
 				return ""
 			}, 10).ShouldNot(BeEmpty())
-		}, 15)
+		})
 		It("created derived service", func() {
 			var s v1.Service
 			Eventually(func() error {
@@ -110,7 +533,49 @@ var _ = Describe("ServiceImport", func() {
 			}, 10).Should(Succeed())
 			Expect(len(s.OwnerReferences)).To(Equal(1))
 			Expect(s.OwnerReferences[0].UID).To(Equal(serviceImport.UID))
-		}, 15)
+		})
+		It("updates derived service ports", func() {
+			var s v1.Service
+			Eventually(func() error {
+				return k8s.Get(ctx, derivedServiceName, &s)
+			}, 10).Should(Succeed())
+			Eventually(func() error {
+				var imp v1beta1.ServiceImport
+				Expect(k8s.Get(ctx, serviceName, &imp)).To(Succeed())
+				imp.Spec.Ports = []v1beta1.ServicePort{{Name: "http", Port: 80}, {Name: "dns", Protocol: v1.ProtocolUDP, Port: 53}}
+				return k8s.Update(ctx, &imp)
+			}, 10).Should(Succeed())
+			Eventually(func() int {
+				Expect(k8s.Get(ctx, derivedServiceName, &s)).To(Succeed())
+				return len(s.Spec.Ports)
+			}, 10).Should(Equal(2))
+		})
+		It("recreates a deleted derived service", func() {
+			var s v1.Service
+			Eventually(func() error {
+				return k8s.Get(ctx, derivedServiceName, &s)
+			}, 10).Should(Succeed())
+			Expect(k8s.Delete(ctx, &s)).To(Succeed())
+			Eventually(func() types.UID {
+				var recreated v1.Service
+				if err := k8s.Get(ctx, derivedServiceName, &recreated); err != nil {
+					return ""
+				}
+				return recreated.UID
+			}, 10).ShouldNot(Or(BeEmpty(), Equal(s.UID)))
+		})
+		It("repairs a modified derived service", func() {
+			var s v1.Service
+			Eventually(func() error {
+				return k8s.Get(ctx, derivedServiceName, &s)
+			}, 10).Should(Succeed())
+			s.Spec.Ports[0].Port = 8080
+			Expect(k8s.Update(ctx, &s)).To(Succeed())
+			Eventually(func() int32 {
+				Expect(k8s.Get(ctx, derivedServiceName, &s)).To(Succeed())
+				return s.Spec.Ports[0].Port
+			}, 10).Should(Equal(int32(80)))
+		})
 		It("removes derived service", func() {
 			var s v1.Service
 			Eventually(func() error {
@@ -122,9 +587,40 @@ var _ = Describe("ServiceImport", func() {
 			Eventually(func() error {
 				return k8s.Get(ctx, derivedServiceName, &s)
 			}, 15).ShouldNot(Succeed())
-		}, 15)
+		})
 	})
-	Context("created with IP", func() {
+	Context("created headless", Label(envtestLabel), func() {
+		BeforeEach(func() {
+			serviceName = types.NamespacedName{Namespace: testNS, Name: fmt.Sprintf("svc-%v", rand.Uint64())}
+			derivedServiceName = types.NamespacedName{Namespace: testNS, Name: derivedName(serviceName)}
+			serviceImport = v1beta1.ServiceImport{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testNS,
+					Name:      serviceName.Name,
+				},
+				Spec: v1beta1.ServiceImportSpec{
+					Type: v1beta1.Headless,
+					Ports: []v1beta1.ServicePort{
+						{Port: 80},
+					},
+				},
+			}
+			Expect(k8s.Create(ctx, &serviceImport)).To(Succeed())
+		})
+		It("created headless derived service", func() {
+			var s v1.Service
+			Eventually(func() error {
+				return k8s.Get(ctx, derivedServiceName, &s)
+			}, 10).Should(Succeed())
+			Expect(s.Spec.ClusterIP).To(Equal(v1.ClusterIPNone))
+			Consistently(func() []string {
+				var imp v1beta1.ServiceImport
+				Expect(k8s.Get(ctx, serviceName, &imp)).To(Succeed())
+				return imp.Spec.IPs
+			}, 3).Should(BeEmpty())
+		})
+	})
+	Context("created with IP", Label(envtestLabel), func() {
 		BeforeEach(func() {
 			serviceName = types.NamespacedName{Namespace: testNS, Name: fmt.Sprintf("svc-%v", rand.Uint64())}
 			derivedServiceName = types.NamespacedName{Namespace: testNS, Name: derivedName(serviceName)}
@@ -155,9 +651,43 @@ var _ = Describe("ServiceImport", func() {
 				}
 				return ""
 			}, 10).Should(Equal(s.Spec.ClusterIP))
-		}, 15)
+		})
 	})
-	Context("created with existing clustersetIP", func() {
+	Context("validated", Label(envtestLabel), func() {
+		DescribeTable("its IPs",
+			func(ips []string, families []v1.IPFamily, valid bool) {
+				err := k8s.Create(ctx, &v1beta1.ServiceImport{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testNS,
+						Name:      fmt.Sprintf("svc-%v", rand.Uint64()),
+					},
+					Spec: v1beta1.ServiceImportSpec{
+						Type:       v1beta1.ClusterSetIP,
+						Ports:      []v1beta1.ServicePort{{Port: 80}},
+						IPs:        ips,
+						IPFamilies: families,
+					},
+				}, client.DryRunAll)
+				if valid {
+					Expect(err).ToNot(HaveOccurred())
+				} else {
+					Expect(apierrors.IsInvalid(err)).To(BeTrue(), "expected an invalid error, got %v", err)
+				}
+			},
+			Entry("accepts an IPv4 address", []string{"10.42.42.42"}, nil, true),
+			Entry("accepts an IPv6 address", []string{"fd00::42"}, nil, true),
+			Entry("accepts dual-stack addresses matching the families",
+				[]string{"fd00::42", "10.42.42.42"}, []v1.IPFamily{v1.IPv6Protocol, v1.IPv4Protocol}, true),
+			Entry("rejects an invalid address", []string{"10.42.42.420"}, nil, false),
+			Entry("rejects a hostname", []string{"svc.example.com"}, nil, false),
+			Entry("rejects an address of another family", []string{"10.42.42.42"}, []v1.IPFamily{v1.IPv6Protocol}, false),
+			Entry("rejects addresses in another order than the families",
+				[]string{"10.42.42.42", "fd00::42"}, []v1.IPFamily{v1.IPv6Protocol, v1.IPv4Protocol}, false),
+			Entry("rejects fewer addresses than families",
+				[]string{"10.42.42.42"}, []v1.IPFamily{v1.IPv4Protocol, v1.IPv6Protocol}, false),
+		)
+	})
+	Context("created with existing clustersetIP", Label(envtestLabel), func() {
 		BeforeEach(func() {
 			serviceName = types.NamespacedName{Namespace: testNS, Name: fmt.Sprintf("svc-%v", rand.Uint64())}
 			derivedServiceName = types.NamespacedName{Namespace: testNS, Name: derivedName(serviceName)}
@@ -189,6 +719,6 @@ var _ = Describe("ServiceImport", func() {
 				}
 				return ""
 			}, 10).Should(Equal(s.Status.LoadBalancer.Ingress[0].IP))
-		}, 15)
+		})
 	})
 })
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+)
+
+const (
+	eventReasonOrphanDeleted          = "OrphanDeleted"
+	eventReasonOrphanFound            = "OrphanFound"
+	eventReasonStaleAnnotationRemoved = "StaleAnnotationRemoved"
+	eventReasonStaleAnnotationFound   = "StaleAnnotationFound"
+
+	// DefaultOrphanGracePeriod is the grace period of the sweeper when
+	// SweeperOptions.GracePeriod is not set.
+	DefaultOrphanGracePeriod = time.Minute
+)
+
+// SweeperOptions configures the OrphanSweeper.
+type SweeperOptions struct {
+	// Interval between sweeps. The sweeper doesn't run if it is zero, the
+	// default.
+	Interval time.Duration
+	// DryRun reports orphans without deleting them.
+	DryRun bool
+	// GracePeriod is the minimum age of a derived Service or an EndpointSlice
+	// for it to be considered orphaned, defaulting to
+	// DefaultOrphanGracePeriod.
+	GracePeriod *time.Duration
+	// EndpointSliceManagedBy is the value of the managed-by label of the
+	// EndpointSlices imported by the MCS implementation. EndpointSlices are
+	// created by the implementation rather than by this controller, so they
+	// are only swept if this is set, and only those labelled with it.
+	EndpointSliceManagedBy string
+}
+
+// OrphanSweeper periodically deletes the objects left behind by
+// ServiceImports that were deleted or recreated, or whose derived Service was
+// renamed by a change of naming strategy: derived Services whose owning
+// ServiceImport no longer exists or names another derived Service,
+// EndpointSlices of multi-cluster services that have no ServiceImport, and
+// derived Service annotations that don't match the naming strategy. Only the
+// Services controlled by a ServiceImport, as derived Services are, and the
+// EndpointSlices managed by SweeperOptions.EndpointSliceManagedBy are swept.
+type OrphanSweeper struct {
+	client.Client
+	Log      logr.Logger
+	Recorder record.EventRecorder
+	Naming   NamingStrategy
+	SweeperOptions
+}
+
+// SweepResult counts the orphans found by a sweep.
+type SweepResult struct {
+	Services         int
+	EndpointSlices   int
+	StaleAnnotations int
+}
+
+// +kubebuilder:rbac:groups=core,resources=services,verbs=list;delete
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=list;delete
+// +kubebuilder:rbac:groups=multicluster.x-k8s.io,resources=serviceimports,verbs=list;update
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+// Start sweeps every Interval until the context is done.
+func (s *OrphanSweeper) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if _, err := s.Sweep(ctx); err != nil {
+			s.Log.Error(err, "sweep failed")
+		}
+	}, s.Interval)
+	return nil
+}
+
+// Sweep finds and, unless DryRun is set, removes orphans once. It carries on
+// after failing to remove an orphan, and returns the errors encountered.
+func (s *OrphanSweeper) Sweep(ctx context.Context) (SweepResult, error) {
+	var result SweepResult
+
+	var svcImports v1beta1.ServiceImportList
+	if err := s.Client.List(ctx, &svcImports); err != nil {
+		return result, err
+	}
+	imports := make(map[types.NamespacedName]*v1beta1.ServiceImport, len(svcImports.Items))
+	for i := range svcImports.Items {
+		svcImport := &svcImports.Items[i]
+		imports[types.NamespacedName{Namespace: svcImport.Namespace, Name: svcImport.Name}] = svcImport
+	}
+
+	var errs []error
+
+	var services v1.ServiceList
+	if err := s.Client.List(ctx, &services); err != nil {
+		return result, err
+	}
+	for i := range services.Items {
+		svc := &services.Items[i]
+		// The ServiceImport of a Service created since the ServiceImports were
+		// listed may be missing from the list.
+		if svc.DeletionTimestamp != nil || !s.isOld(svc) || !isOrphanedDerivedService(svc, imports) {
+			continue
+		}
+		result.Services++
+		errs = append(errs, s.deleteOrphan(ctx, svc, "derived Service of a ServiceImport that no longer exists"))
+	}
+
+	var epSlices discoveryv1.EndpointSliceList
+	if s.EndpointSliceManagedBy != "" {
+		if err := s.Client.List(ctx, &epSlices, client.HasLabels{v1beta1.LabelServiceName},
+			client.MatchingLabels{discoveryv1.LabelManagedBy: s.EndpointSliceManagedBy}); err != nil {
+			return result, err
+		}
+	}
+	for i := range epSlices.Items {
+		epSlice := &epSlices.Items[i]
+		// Imported EndpointSlices may be created before their ServiceImport,
+		// give the ServiceImport time to appear.
+		if epSlice.DeletionTimestamp != nil || !s.isOld(epSlice) {
+			continue
+		}
+		if _, found := imports[types.NamespacedName{Namespace: epSlice.Namespace, Name: epSlice.Labels[v1beta1.LabelServiceName]}]; found {
+			continue
+		}
+		result.EndpointSlices++
+		errs = append(errs, s.deleteOrphan(ctx, epSlice, "EndpointSlice of a ServiceImport that no longer exists"))
+	}
+
+	for name, svcImport := range imports {
+		annotation := svcImport.Annotations[DerivedServiceAnnotation]
+		if annotation == "" || annotation == s.Naming.DerivedName(name) {
+			continue
+		}
+		result.StaleAnnotations++
+		errs = append(errs, s.removeStaleAnnotation(ctx, svcImport))
+	}
+
+	s.Log.Info("sweep complete", "dryRun", s.DryRun, "services", result.Services,
+		"endpointSlices", result.EndpointSlices, "staleAnnotations", result.StaleAnnotations)
+	return result, errors.Join(errs...)
+}
+
+func (s *OrphanSweeper) isOld(obj client.Object) bool {
+	gracePeriod := DefaultOrphanGracePeriod
+	if s.GracePeriod != nil {
+		gracePeriod = *s.GracePeriod
+	}
+	return time.Since(obj.GetCreationTimestamp().Time) >= gracePeriod
+}
+
+// isOrphanedDerivedService reports whether svc is controlled by a
+// ServiceImport that doesn't exist, that was recreated since it created svc,
+// or that was renamed to another derived Service.
+func isOrphanedDerivedService(svc *v1.Service, imports map[types.NamespacedName]*v1beta1.ServiceImport) bool {
+	ref := metav1.GetControllerOf(svc)
+	if ref == nil || ref.APIVersion != v1beta1.GroupVersion.String() || ref.Kind != serviceImportKind {
+		return false
+	}
+	svcImport, found := imports[types.NamespacedName{Namespace: svc.Namespace, Name: ref.Name}]
+	if !found || svcImport.UID != ref.UID {
+		return true
+	}
+	annotation := svcImport.Annotations[DerivedServiceAnnotation]
+	return annotation != "" && annotation != svc.Name
+}
+
+func (s *OrphanSweeper) deleteOrphan(ctx context.Context, obj client.Object, description string) error {
+	log := s.Log.WithValues("object", client.ObjectKeyFromObject(obj), "description", description)
+	if s.DryRun {
+		log.Info("found orphan")
+		s.Recorder.Event(obj, v1.EventTypeNormal, eventReasonOrphanFound, "Found orphaned "+description)
+		return nil
+	}
+	if err := s.Client.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+	log.Info("deleted orphan")
+	s.Recorder.Event(obj, v1.EventTypeNormal, eventReasonOrphanDeleted, "Deleted orphaned "+description)
+	return nil
+}
+
+func (s *OrphanSweeper) removeStaleAnnotation(ctx context.Context, svcImport *v1beta1.ServiceImport) error {
+	annotation := svcImport.Annotations[DerivedServiceAnnotation]
+	log := s.Log.WithValues("serviceimport", client.ObjectKeyFromObject(svcImport), DerivedServiceAnnotation, annotation)
+	if s.DryRun {
+		log.Info("found stale annotation")
+		s.Recorder.Eventf(svcImport, v1.EventTypeNormal, eventReasonStaleAnnotationFound,
+			"Found stale derived Service annotation %q", annotation)
+		return nil
+	}
+	delete(svcImport.Annotations, DerivedServiceAnnotation)
+	if err := s.Client.Update(ctx, svcImport); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+	log.Info("removed stale annotation")
+	s.Recorder.Eventf(svcImport, v1.EventTypeNormal, eventReasonStaleAnnotationRemoved,
+		"Removed stale derived Service annotation %q", annotation)
+	return nil
+}
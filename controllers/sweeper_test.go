@@ -0,0 +1,283 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+)
+
+var _ = Describe("OrphanSweeper", func() {
+	var (
+		sweeper   *OrphanSweeper
+		recorder  *record.FakeRecorder
+		svcImport *v1beta1.ServiceImport
+		ctx       = context.Background()
+	)
+
+	importName := types.NamespacedName{Namespace: "ns", Name: "svc"}
+	old := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	derivedService := func(name string, owner *v1beta1.ServiceImport) *v1.Service {
+		return &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         owner.Namespace,
+				Name:              name,
+				CreationTimestamp: old,
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: v1beta1.GroupVersion.String(),
+					Kind:       serviceImportKind,
+					Name:       owner.Name,
+					UID:        owner.UID,
+					Controller: ptr.To(true),
+				}},
+			},
+		}
+	}
+
+	endpointSlice := func(name, serviceName string, created metav1.Time) *discoveryv1.EndpointSlice {
+		return &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "ns",
+				Name:              name,
+				CreationTimestamp: created,
+				Labels: map[string]string{
+					v1beta1.LabelServiceName:   serviceName,
+					discoveryv1.LabelManagedBy: "mcs-implementation",
+				},
+			},
+			AddressType: discoveryv1.AddressTypeIPv4,
+		}
+	}
+
+	withObjects := func(objs ...client.Object) {
+		recorder = record.NewFakeRecorder(10)
+		sweeper = &OrphanSweeper{
+			Client:   newFakeClient(interceptor.Funcs{}, objs...),
+			Log:      logr.Discard(),
+			Recorder: recorder,
+			Naming:   HashNamingStrategy{},
+			SweeperOptions: SweeperOptions{
+				EndpointSliceManagedBy: "mcs-implementation",
+			},
+		}
+	}
+
+	exists := func(obj client.Object) bool {
+		err := sweeper.Client.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+		if apierrors.IsNotFound(err) {
+			return false
+		}
+		Expect(err).ToNot(HaveOccurred())
+		return true
+	}
+
+	BeforeEach(func() {
+		svcImport = &v1beta1.ServiceImport{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   importName.Namespace,
+				Name:        importName.Name,
+				UID:         "current",
+				Annotations: map[string]string{DerivedServiceAnnotation: derivedName(importName)},
+			},
+		}
+	})
+
+	Context("with no orphans", func() {
+		It("should leave everything in place", func() {
+			svc := derivedService(derivedName(importName), svcImport)
+			epSlice := endpointSlice("slice", importName.Name, old)
+			withObjects(svcImport, svc, epSlice, &v1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "plain"}})
+
+			result, err := sweeper.Sweep(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(SweepResult{}))
+			Expect(exists(svc)).To(BeTrue())
+			Expect(exists(epSlice)).To(BeTrue())
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
+
+	Context("with orphaned derived Services", func() {
+		It("should delete Services of a missing or recreated ServiceImport", func() {
+			missing := derivedService("derived-missing", &v1beta1.ServiceImport{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gone", UID: "gone"},
+			})
+			recreated := derivedService("derived-recreated", &v1beta1.ServiceImport{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: importName.Name, UID: "previous"},
+			})
+			current := derivedService(derivedName(importName), svcImport)
+			withObjects(svcImport, missing, recreated, current)
+
+			result, err := sweeper.Sweep(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(SweepResult{Services: 2}))
+			Expect(exists(missing)).To(BeFalse())
+			Expect(exists(recreated)).To(BeFalse())
+			Expect(exists(current)).To(BeTrue())
+			Expect(recorder.Events).To(HaveLen(2))
+			Expect(<-recorder.Events).To(ContainSubstring(eventReasonOrphanDeleted))
+		})
+
+		It("should only delete Services older than the grace period", func() {
+			gone := &v1beta1.ServiceImport{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gone", UID: "gone"}}
+			young := derivedService("derived-young", gone)
+			young.CreationTimestamp = metav1.NewTime(time.Now().Add(-30 * time.Second))
+			aged := derivedService("derived-aged", gone)
+			aged.CreationTimestamp = metav1.NewTime(time.Now().Add(-2 * time.Minute))
+			withObjects(svcImport, young, aged)
+
+			result, err := sweeper.Sweep(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(SweepResult{Services: 1}))
+			Expect(exists(young)).To(BeTrue())
+			Expect(exists(aged)).To(BeFalse())
+
+			sweeper.GracePeriod = ptr.To(10 * time.Second)
+			result, err = sweeper.Sweep(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(SweepResult{Services: 1}))
+			Expect(exists(young)).To(BeFalse())
+		})
+
+		It("should leave recent Services and Services it doesn't control alone", func() {
+			gone := &v1beta1.ServiceImport{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gone", UID: "gone"}}
+			recent := derivedService("derived-recent", gone)
+			recent.CreationTimestamp = metav1.Now()
+			notControlled := derivedService("derived-not-controlled", gone)
+			notControlled.OwnerReferences[0].Controller = nil
+			withObjects(svcImport, recent, notControlled)
+
+			result, err := sweeper.Sweep(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(SweepResult{}))
+			Expect(exists(recent)).To(BeTrue())
+			Expect(exists(notControlled)).To(BeTrue())
+		})
+	})
+
+	Context("after a change of naming strategy", func() {
+		It("should remove the stale annotation, then the previously derived Service", func() {
+			svc := derivedService(derivedName(importName), svcImport)
+			withObjects(svcImport, svc)
+			sweeper.Naming = SuffixNamingStrategy{}
+
+			result, err := sweeper.Sweep(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(SweepResult{StaleAnnotations: 1}))
+			Expect(exists(svc)).To(BeTrue())
+
+			// The ServiceImport controller derives a Service with the new name.
+			Expect(exists(svcImport)).To(BeTrue())
+			svcImport.Annotations = map[string]string{DerivedServiceAnnotation: SuffixNamingStrategy{}.DerivedName(importName)}
+			Expect(sweeper.Client.Update(ctx, svcImport)).To(Succeed())
+
+			result, err = sweeper.Sweep(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(SweepResult{Services: 1}))
+			Expect(exists(svc)).To(BeFalse())
+		})
+	})
+
+	Context("with orphaned EndpointSlices", func() {
+		It("should delete EndpointSlices without a ServiceImport once the grace period passed", func() {
+			orphan := endpointSlice("orphan", "gone", old)
+			recent := endpointSlice("recent", "gone", metav1.Now())
+			imported := endpointSlice("imported", importName.Name, old)
+			withObjects(svcImport, orphan, recent, imported)
+
+			result, err := sweeper.Sweep(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(SweepResult{EndpointSlices: 1}))
+			Expect(exists(orphan)).To(BeFalse())
+			Expect(exists(recent)).To(BeTrue())
+			Expect(exists(imported)).To(BeTrue())
+		})
+
+		It("should only delete EndpointSlices managed by the MCS implementation", func() {
+			orphan := endpointSlice("orphan", "gone", old)
+			other := endpointSlice("other", "gone", old)
+			other.Labels[discoveryv1.LabelManagedBy] = "other-controller"
+			withObjects(svcImport, orphan, other)
+			sweeper.EndpointSliceManagedBy = ""
+
+			result, err := sweeper.Sweep(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(SweepResult{}))
+
+			sweeper.EndpointSliceManagedBy = "mcs-implementation"
+			result, err = sweeper.Sweep(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(SweepResult{EndpointSlices: 1}))
+			Expect(exists(orphan)).To(BeFalse())
+			Expect(exists(other)).To(BeTrue())
+		})
+	})
+
+	Context("with a stale derived Service annotation", func() {
+		It("should remove the annotation", func() {
+			svcImport.Annotations[DerivedServiceAnnotation] = "stale"
+			withObjects(svcImport)
+
+			result, err := sweeper.Sweep(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(SweepResult{StaleAnnotations: 1}))
+			Expect(exists(svcImport)).To(BeTrue())
+			Expect(svcImport.Annotations).ToNot(HaveKey(DerivedServiceAnnotation))
+			Expect(<-recorder.Events).To(ContainSubstring(eventReasonStaleAnnotationRemoved))
+		})
+	})
+
+	Context("in dry-run mode", func() {
+		It("should only report orphans", func() {
+			svcImport.Annotations[DerivedServiceAnnotation] = "stale"
+			svc := derivedService("derived-missing", &v1beta1.ServiceImport{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gone", UID: "gone"},
+			})
+			epSlice := endpointSlice("orphan", "gone", old)
+			withObjects(svcImport, svc, epSlice)
+			sweeper.DryRun = true
+
+			result, err := sweeper.Sweep(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(SweepResult{Services: 1, EndpointSlices: 1, StaleAnnotations: 1}))
+			Expect(exists(svc)).To(BeTrue())
+			Expect(exists(epSlice)).To(BeTrue())
+			Expect(exists(svcImport)).To(BeTrue())
+			Expect(svcImport.Annotations).To(HaveKeyWithValue(DerivedServiceAnnotation, "stale"))
+			Expect(recorder.Events).To(HaveLen(3))
+			for range 2 {
+				Expect(<-recorder.Events).To(ContainSubstring(eventReasonOrphanFound))
+			}
+			Expect(<-recorder.Events).To(ContainSubstring(eventReasonStaleAnnotationFound))
+		})
+	})
+})
@@ -20,7 +20,7 @@ set -o pipefail
 
 SCRIPT_ROOT=$(dirname "${BASH_SOURCE}")/..
 
-go -C tools install k8s.io/code-generator/cmd/{client-gen,lister-gen,informer-gen,deepcopy-gen,register-gen}
+go -C tools install k8s.io/code-generator/cmd/{client-gen,lister-gen,informer-gen,deepcopy-gen,register-gen,conversion-gen}
 
 # Go installs the above commands to get installed in $GOBIN if defined, and $GOPATH/bin otherwise:
 GOBIN="$(go env GOBIN)"
@@ -62,6 +62,9 @@ echo "Generating informers at ${OUTPUT_PKG}/informers"
 echo "Generating register at ${FQ_APIS_V1ALPHA1} & ${FQ_APIS_V1BETA1}"
 "${gobin}/register-gen" "${FQ_APIS_V1ALPHA1}" "${FQ_APIS_V1BETA1}" --output-file zz_generated.register.go ${COMMON_FLAGS}
 
+echo "Generating conversions at ${FQ_APIS_V1ALPHA1}"
+"${gobin}/conversion-gen" "${FQ_APIS_V1ALPHA1}" --output-file zz_generated.conversion.go ${COMMON_FLAGS}
+
 if [[ "${VERIFY_CODEGEN:-}" == "true" ]]; then
   diff -urN "$ORIG_OUTPUT_DIR" "$OUTPUT_DIR"
 fi
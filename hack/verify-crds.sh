@@ -41,7 +41,8 @@ cp -a "${DIFFROOT}"/* "${TMP_DIFFROOT}"
 
 ${CONTROLLER_GEN} ${CRD_OPTIONS} rbac:roleName=mcs-derived-service-manager webhook \
 paths="${SCRIPT_ROOT}/..." schemapatch:manifests="${SCRIPT_ROOT}/config/crd-base" output:crd:none \
-output:schemapatch:dir="${TMP_DIFFROOT}/crd" output:rbac:dir="${TMP_DIFFROOT}/rbac"
+output:schemapatch:dir="${TMP_DIFFROOT}/crd" output:rbac:dir="${TMP_DIFFROOT}/rbac" \
+output:webhook:dir="${TMP_DIFFROOT}/webhook"
 
 echo "diffing ${DIFFROOT} against freshly generated codegen in ${TMP_DIFFROOT}"
 ret=0
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ClusterPropertyPluralName is the plural name of ClusterProperty
+	ClusterPropertyPluralName = "clusterproperties"
+	// ClusterPropertyKindName is the kind name of ClusterProperty
+	ClusterPropertyKindName = "ClusterProperty"
+	// ClusterPropertyFullName is the full name of ClusterProperty
+	ClusterPropertyFullName = ClusterPropertyPluralName + "." + GroupName
+)
+
+// ClusterPropertyVersionedName is the versioned name of ClusterProperty
+var ClusterPropertyVersionedName = ClusterPropertyKindName + "/" + GroupVersion.Version
+
+const (
+	// ClusterIDPropertyName is the name of the ClusterProperty holding the
+	// cluster ID. Its value must be a valid RFC-1123 DNS label and is used to
+	// populate the LabelSourceCluster label and ClusterStatus.Cluster.
+	ClusterIDPropertyName = "cluster.clusterset.k8s.io"
+	// ClusterSetPropertyName is the name of the ClusterProperty holding the
+	// name of the ClusterSet the cluster belongs to.
+	ClusterSetPropertyName = "clusterset.k8s.io"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName={clusterprop}
+
+// ClusterProperty is a name/value pair describing a property of the cluster,
+// such as its ID within a ClusterSet.
+type ClusterProperty struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec defines the value of the property.
+	Spec ClusterPropertySpec `json:"spec"`
+}
+
+// ClusterPropertySpec holds the value of a ClusterProperty.
+type ClusterPropertySpec struct {
+	// value is the property's value.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128000
+	Value string `json:"value"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterPropertyList represents a list of cluster properties
+type ClusterPropertyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of cluster properties
+	// +listType=set
+	Items []ClusterProperty `json:"items"`
+}
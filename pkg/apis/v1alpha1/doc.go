@@ -17,5 +17,6 @@ limitations under the License.
 // Package v1alpha1 contains API schema definitions for the Multi-Cluster
 // Services v1alpha1 API group.
 // +kubebuilder:object:generate=true
+// +k8s:conversion-gen=sigs.k8s.io/mcs-api/pkg/apis/v1beta1
 // +groupName=multicluster.x-k8s.io
 package v1alpha1
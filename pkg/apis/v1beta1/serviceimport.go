@@ -63,11 +63,21 @@ const (
 )
 
 // ServiceImportSpec describes an imported service and the information necessary to consume it.
+// +kubebuilder:validation:XValidation:rule="self.type != 'Headless' || !has(self.ips) || size(self.ips) == 0",message="ips must not be set when type is Headless"
+// +kubebuilder:validation:XValidation:rule="!has(self.ips) || !has(self.ipFamilies) || (size(self.ips) == size(self.ipFamilies) && (size(self.ips) < 1 || ip(self.ips[0]).family() == (self.ipFamilies[0] == 'IPv4' ? 4 : 6)) && (size(self.ips) < 2 || ip(self.ips[1]).family() == (self.ipFamilies[1] == 'IPv4' ? 4 : 6)))",message="the family of each of ips must match ipFamilies, in order"
+// +kubebuilder:validation:XValidation:rule="!has(self.sessionAffinityConfig) || (has(self.sessionAffinity) && self.sessionAffinity == 'ClientIP')",message="sessionAffinityConfig may only be set when sessionAffinity is ClientIP"
 type ServiceImportSpec struct {
 	// +listType=atomic
+	// +kubebuilder:validation:MaxItems:=100
+	// +kubebuilder:validation:XValidation:rule="size(self) <= 1 || self.all(p, has(p.name))",message="all ports must be named when there is more than one port"
+	// +kubebuilder:validation:XValidation:rule="self.all(p, !has(p.name) || self.exists_one(q, has(q.name) && q.name == p.name))",message="port names must be unique"
 	Ports []ServicePort `json:"ports"`
 	// ip will be used as the VIP for this service when type is ClusterSetIP.
+	// The IPs are validated with the CEL IP library, which requires
+	// kube-apiserver 1.31 or later.
 	// +kubebuilder:validation:MaxItems:=2
+	// +kubebuilder:validation:items:MaxLength:=45
+	// +kubebuilder:validation:XValidation:rule="self.all(i, isIP(i))",message="ips must be valid IP addresses"
 	// +optional
 	IPs []string `json:"ips,omitempty"`
 	// type defines the type of this service.
@@ -116,6 +126,7 @@ type ServicePort struct {
 	// the endpoints for a Service, this must match the 'name' field in the
 	// EndpointPort.
 	// Optional if only one ServicePort is defined on this service.
+	// +kubebuilder:validation:MaxLength:=63
 	// +optional
 	Name string `json:"name,omitempty"`
 
@@ -0,0 +1,241 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validation validates ServiceExport and ServiceImport objects. It
+// covers the rules enforced by the CRD schemas and more, so that controllers
+// and admission webhooks can reject invalid objects with precise errors.
+package validation
+
+import (
+	v1 "k8s.io/api/core/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilnet "k8s.io/utils/net"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+)
+
+var (
+	supportedServiceImportTypes = sets.New(v1beta1.ClusterSetIP, v1beta1.Headless)
+	supportedPortProtocols      = sets.New(v1.ProtocolTCP, v1.ProtocolUDP, v1.ProtocolSCTP)
+	supportedIPFamilies         = sets.New(v1.IPv4Protocol, v1.IPv6Protocol)
+	supportedSessionAffinities  = sets.New(v1.ServiceAffinityNone, v1.ServiceAffinityClientIP)
+)
+
+// ValidateServiceImport validates a ServiceImport.
+func ValidateServiceImport(svcImport *v1beta1.ServiceImport) field.ErrorList {
+	metaPath := field.NewPath("metadata")
+	allErrs := apivalidation.ValidateObjectMeta(&svcImport.ObjectMeta, true, apivalidation.NameIsDNS1035Label, metaPath)
+	allErrs = append(allErrs, validateSourceClusterLabel(svcImport.Labels, metaPath.Child("labels"))...)
+	allErrs = append(allErrs, validateServiceImportSpec(&svcImport.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateServiceImportStatus(&svcImport.Status, field.NewPath("status"))...)
+	return allErrs
+}
+
+// ValidateServiceImportUpdate validates an update of oldSvcImport to svcImport.
+func ValidateServiceImportUpdate(svcImport, oldSvcImport *v1beta1.ServiceImport) field.ErrorList {
+	allErrs := apivalidation.ValidateObjectMetaUpdate(&svcImport.ObjectMeta, &oldSvcImport.ObjectMeta, field.NewPath("metadata"))
+	return append(allErrs, ValidateServiceImport(svcImport)...)
+}
+
+// ValidateServiceExport validates a ServiceExport.
+func ValidateServiceExport(svcExport *v1beta1.ServiceExport) field.ErrorList {
+	metaPath := field.NewPath("metadata")
+	allErrs := apivalidation.ValidateObjectMeta(&svcExport.ObjectMeta, true, apivalidation.NameIsDNS1035Label, metaPath)
+	allErrs = append(allErrs, validateSourceClusterLabel(svcExport.Labels, metaPath.Child("labels"))...)
+
+	specPath := field.NewPath("spec")
+	allErrs = append(allErrs, metav1validation.ValidateLabels(svcExport.Spec.ExportedLabels, specPath.Child("exportedLabels"))...)
+	allErrs = append(allErrs, apivalidation.ValidateAnnotations(svcExport.Spec.ExportedAnnotations, specPath.Child("exportedAnnotations"))...)
+
+	allErrs = append(allErrs, metav1validation.ValidateConditions(svcExport.Status.Conditions, field.NewPath("status", "conditions"))...)
+	return allErrs
+}
+
+func validateServiceImportSpec(spec *v1beta1.ServiceImportSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	typePath := fldPath.Child("type")
+	if spec.Type == "" {
+		allErrs = append(allErrs, field.Required(typePath, ""))
+	} else if !supportedServiceImportTypes.Has(spec.Type) {
+		allErrs = append(allErrs, field.NotSupported(typePath, spec.Type, sets.List(supportedServiceImportTypes)))
+	}
+
+	allErrs = append(allErrs, validatePorts(spec.Ports, fldPath.Child("ports"))...)
+	allErrs = append(allErrs, validateIPFamilies(spec.IPFamilies, fldPath.Child("ipFamilies"))...)
+	allErrs = append(allErrs, validateIPs(spec, fldPath.Child("ips"))...)
+
+	if spec.SessionAffinity != "" && !supportedSessionAffinities.Has(spec.SessionAffinity) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("sessionAffinity"), spec.SessionAffinity,
+			sets.List(supportedSessionAffinities)))
+	}
+	if spec.SessionAffinityConfig != nil && spec.SessionAffinity != v1.ServiceAffinityClientIP {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("sessionAffinityConfig"),
+			"may only be set when sessionAffinity is ClientIP"))
+	}
+
+	return allErrs
+}
+
+func validatePorts(ports []v1beta1.ServicePort, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	names := sets.New[string]()
+	for i := range ports {
+		port := &ports[i]
+		idxPath := fldPath.Index(i)
+
+		namePath := idxPath.Child("name")
+		if port.Name == "" {
+			if len(ports) > 1 {
+				allErrs = append(allErrs, field.Required(namePath, "must be set when there is more than one port"))
+			}
+		} else {
+			for _, msg := range validation.IsDNS1123Label(port.Name) {
+				allErrs = append(allErrs, field.Invalid(namePath, port.Name, msg))
+			}
+			if names.Has(port.Name) {
+				allErrs = append(allErrs, field.Duplicate(namePath, port.Name))
+			}
+			names.Insert(port.Name)
+		}
+
+		for _, msg := range validation.IsValidPortNum(int(port.Port)) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("port"), port.Port, msg))
+		}
+
+		if port.Protocol != "" && !supportedPortProtocols.Has(port.Protocol) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("protocol"), port.Protocol,
+				sets.List(supportedPortProtocols)))
+		}
+
+		if port.AppProtocol != nil {
+			for _, msg := range validation.IsQualifiedName(*port.AppProtocol) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("appProtocol"), *port.AppProtocol, msg))
+			}
+		}
+	}
+	return allErrs
+}
+
+func validateIPFamilies(ipFamilies []v1.IPFamily, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(ipFamilies) > 2 {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(ipFamilies), 2))
+	}
+	seen := sets.New[v1.IPFamily]()
+	for i, family := range ipFamilies {
+		if !supportedIPFamilies.Has(family) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(i), family, sets.List(supportedIPFamilies)))
+		} else if seen.Has(family) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), family))
+		}
+		seen.Insert(family)
+	}
+	return allErrs
+}
+
+func validateIPs(spec *v1beta1.ServiceImportSpec, fldPath *field.Path) field.ErrorList {
+	if len(spec.IPs) == 0 {
+		return nil
+	}
+	if spec.Type == v1beta1.Headless {
+		return field.ErrorList{field.Forbidden(fldPath, "may not be set when type is Headless")}
+	}
+	if len(spec.IPs) > 2 {
+		return field.ErrorList{field.TooMany(fldPath, len(spec.IPs), 2)}
+	}
+
+	var allErrs field.ErrorList
+	families := make([]v1.IPFamily, 0, len(spec.IPs))
+	for i, ip := range spec.IPs {
+		if errs := validation.IsValidIP(fldPath.Index(i), ip); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+		families = append(families, ipFamilyOf(ip))
+	}
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	if len(families) == 2 && families[0] == families[1] {
+		allErrs = append(allErrs, field.Invalid(fldPath, spec.IPs, "may contain at most one IP of each family"))
+	}
+	if len(spec.IPFamilies) > 0 {
+		if len(spec.IPFamilies) != len(spec.IPs) {
+			allErrs = append(allErrs, field.Invalid(fldPath, spec.IPs, "must contain one IP for each of ipFamilies"))
+		} else {
+			for i := range families {
+				if families[i] != spec.IPFamilies[i] {
+					allErrs = append(allErrs, field.Invalid(fldPath.Index(i), spec.IPs[i],
+						"must be of the IP family at the same index in ipFamilies, "+string(spec.IPFamilies[i])))
+				}
+			}
+		}
+	}
+	return allErrs
+}
+
+func validateServiceImportStatus(status *v1beta1.ServiceImportStatus, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	clustersPath := fldPath.Child("clusters")
+	clusters := sets.New[string]()
+	for i := range status.Clusters {
+		clusterPath := clustersPath.Index(i).Child("cluster")
+		cluster := status.Clusters[i].Cluster
+		if cluster == "" {
+			allErrs = append(allErrs, field.Required(clusterPath, ""))
+			continue
+		}
+		allErrs = append(allErrs, validateClusterName(cluster, clusterPath)...)
+		if clusters.Has(cluster) {
+			allErrs = append(allErrs, field.Duplicate(clusterPath, cluster))
+		}
+		clusters.Insert(cluster)
+	}
+	allErrs = append(allErrs, metav1validation.ValidateConditions(status.Conditions, fldPath.Child("conditions"))...)
+	return allErrs
+}
+
+// validateSourceClusterLabel checks that the LabelSourceCluster label, if set,
+// holds a valid cluster name.
+func validateSourceClusterLabel(labels map[string]string, fldPath *field.Path) field.ErrorList {
+	cluster, ok := labels[v1beta1.LabelSourceCluster]
+	if !ok {
+		return nil
+	}
+	return validateClusterName(cluster, fldPath.Key(v1beta1.LabelSourceCluster))
+}
+
+// validateClusterName checks that a cluster name can be used as a label in
+// the DNS names of multi-cluster services.
+func validateClusterName(cluster string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, msg := range validation.IsDNS1123Label(cluster) {
+		allErrs = append(allErrs, field.Invalid(fldPath, cluster, msg))
+	}
+	return allErrs
+}
+
+func ipFamilyOf(ip string) v1.IPFamily {
+	if utilnet.IsIPv6String(ip) {
+		return v1.IPv6Protocol
+	}
+	return v1.IPv4Protocol
+}
@@ -0,0 +1,431 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+)
+
+type expectedError struct {
+	errType field.ErrorType
+	field   string
+}
+
+func checkErrors(t *testing.T, errs field.ErrorList, expected []expectedError) {
+	t.Helper()
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %d: %v", len(expected), len(errs), errs)
+	}
+	for i, err := range errs {
+		if err.Type != expected[i].errType || err.Field != expected[i].field {
+			t.Errorf("expected error %d to be %s on %q, got: %v", i, expected[i].errType, expected[i].field, err)
+		}
+	}
+}
+
+func validServiceImport() *v1beta1.ServiceImport {
+	return &v1beta1.ServiceImport{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "svc",
+			Labels:    map[string]string{v1beta1.LabelSourceCluster: "cluster-a"},
+		},
+		Spec: v1beta1.ServiceImportSpec{
+			Type: v1beta1.ClusterSetIP,
+			Ports: []v1beta1.ServicePort{
+				{Name: "http", Protocol: v1.ProtocolTCP, Port: 80, AppProtocol: ptr.To("kubernetes.io/h2c")},
+				{Name: "dns", Protocol: v1.ProtocolUDP, Port: 53},
+			},
+			IPs:                   []string{"10.42.42.42", "fd00::42"},
+			IPFamilies:            []v1.IPFamily{v1.IPv4Protocol, v1.IPv6Protocol},
+			SessionAffinity:       v1.ServiceAffinityClientIP,
+			SessionAffinityConfig: &v1.SessionAffinityConfig{ClientIP: &v1.ClientIPConfig{TimeoutSeconds: ptr.To[int32](10)}},
+		},
+		Status: v1beta1.ServiceImportStatus{
+			Clusters: []v1beta1.ClusterStatus{{Cluster: "cluster-a"}, {Cluster: "cluster-b"}},
+		},
+	}
+}
+
+func TestValidateServiceImport(t *testing.T) {
+	tests := []struct {
+		name     string
+		tweak    func(*v1beta1.ServiceImport)
+		expected []expectedError
+	}{
+		{
+			name:  "valid",
+			tweak: func(*v1beta1.ServiceImport) {},
+		},
+		{
+			name: "valid headless",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.Type = v1beta1.Headless
+				si.Spec.IPs = nil
+			},
+		},
+		{
+			name: "valid single unnamed port",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.Ports = []v1beta1.ServicePort{{Port: 80}}
+			},
+		},
+		{
+			name: "valid IPs without IP families",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.IPFamilies = nil
+			},
+		},
+		{
+			name: "invalid name",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Name = "1svc"
+			},
+			expected: []expectedError{{field.ErrorTypeInvalid, "metadata.name"}},
+		},
+		{
+			name: "missing namespace",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Namespace = ""
+			},
+			expected: []expectedError{{field.ErrorTypeRequired, "metadata.namespace"}},
+		},
+		{
+			name: "invalid source cluster label",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Labels[v1beta1.LabelSourceCluster] = "Cluster_A"
+			},
+			expected: []expectedError{{field.ErrorTypeInvalid, "metadata.labels[" + v1beta1.LabelSourceCluster + "]"}},
+		},
+		{
+			name: "missing type",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.Type = ""
+			},
+			expected: []expectedError{{field.ErrorTypeRequired, "spec.type"}},
+		},
+		{
+			name: "unsupported type",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.Type = "LoadBalancer"
+			},
+			expected: []expectedError{{field.ErrorTypeNotSupported, "spec.type"}},
+		},
+		{
+			name: "unnamed port among several",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.Ports[1].Name = ""
+			},
+			expected: []expectedError{{field.ErrorTypeRequired, "spec.ports[1].name"}},
+		},
+		{
+			name: "invalid port name",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.Ports[0].Name = "HTTP"
+			},
+			expected: []expectedError{{field.ErrorTypeInvalid, "spec.ports[0].name"}},
+		},
+		{
+			name: "duplicate port name",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.Ports[1].Name = "http"
+			},
+			expected: []expectedError{{field.ErrorTypeDuplicate, "spec.ports[1].name"}},
+		},
+		{
+			name: "invalid port number",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.Ports[0].Port = 0
+				si.Spec.Ports[1].Port = 65536
+			},
+			expected: []expectedError{
+				{field.ErrorTypeInvalid, "spec.ports[0].port"},
+				{field.ErrorTypeInvalid, "spec.ports[1].port"},
+			},
+		},
+		{
+			name: "unsupported port protocol",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.Ports[0].Protocol = "HTTP"
+			},
+			expected: []expectedError{{field.ErrorTypeNotSupported, "spec.ports[0].protocol"}},
+		},
+		{
+			name: "invalid app protocol",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.Ports[0].AppProtocol = ptr.To("not a protocol")
+			},
+			expected: []expectedError{{field.ErrorTypeInvalid, "spec.ports[0].appProtocol"}},
+		},
+		{
+			name: "invalid IP",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.IPs[1] = "fd00::zz"
+			},
+			expected: []expectedError{{field.ErrorTypeInvalid, "spec.ips[1]"}},
+		},
+		{
+			name: "too many IPs",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.IPs = append(si.Spec.IPs, "10.42.42.43")
+			},
+			expected: []expectedError{{field.ErrorTypeTooMany, "spec.ips"}},
+		},
+		{
+			name: "IPs of the same family",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.IPs[1] = "10.42.42.43"
+				si.Spec.IPFamilies = nil
+			},
+			expected: []expectedError{{field.ErrorTypeInvalid, "spec.ips"}},
+		},
+		{
+			name: "IPs not matching the IP families order",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.IPs = []string{"fd00::42", "10.42.42.42"}
+			},
+			expected: []expectedError{
+				{field.ErrorTypeInvalid, "spec.ips[0]"},
+				{field.ErrorTypeInvalid, "spec.ips[1]"},
+			},
+		},
+		{
+			name: "fewer IPs than IP families",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.IPs = si.Spec.IPs[:1]
+			},
+			expected: []expectedError{{field.ErrorTypeInvalid, "spec.ips"}},
+		},
+		{
+			name: "headless with IPs",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.Type = v1beta1.Headless
+			},
+			expected: []expectedError{{field.ErrorTypeForbidden, "spec.ips"}},
+		},
+		{
+			name: "unsupported IP family",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.IPs = nil
+				si.Spec.IPFamilies[1] = "IPv5"
+			},
+			expected: []expectedError{{field.ErrorTypeNotSupported, "spec.ipFamilies[1]"}},
+		},
+		{
+			name: "duplicate IP family",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.IPs = nil
+				si.Spec.IPFamilies[1] = v1.IPv4Protocol
+			},
+			expected: []expectedError{{field.ErrorTypeDuplicate, "spec.ipFamilies[1]"}},
+		},
+		{
+			name: "too many IP families",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.IPs = nil
+				si.Spec.IPFamilies = []v1.IPFamily{v1.IPv4Protocol, v1.IPv6Protocol, v1.IPv4Protocol}
+			},
+			expected: []expectedError{
+				{field.ErrorTypeTooMany, "spec.ipFamilies"},
+				{field.ErrorTypeDuplicate, "spec.ipFamilies[2]"},
+			},
+		},
+		{
+			name: "unsupported session affinity",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.SessionAffinity = "Cookie"
+			},
+			expected: []expectedError{
+				{field.ErrorTypeNotSupported, "spec.sessionAffinity"},
+				{field.ErrorTypeForbidden, "spec.sessionAffinityConfig"},
+			},
+		},
+		{
+			name: "session affinity config without ClientIP",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.SessionAffinity = v1.ServiceAffinityNone
+			},
+			expected: []expectedError{{field.ErrorTypeForbidden, "spec.sessionAffinityConfig"}},
+		},
+		{
+			name: "missing cluster name",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Status.Clusters[0].Cluster = ""
+			},
+			expected: []expectedError{{field.ErrorTypeRequired, "status.clusters[0].cluster"}},
+		},
+		{
+			name: "invalid cluster name",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Status.Clusters[1].Cluster = "cluster.b"
+			},
+			expected: []expectedError{{field.ErrorTypeInvalid, "status.clusters[1].cluster"}},
+		},
+		{
+			name: "too long cluster name",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Status.Clusters[1].Cluster = strings.Repeat("c", 64)
+			},
+			expected: []expectedError{{field.ErrorTypeInvalid, "status.clusters[1].cluster"}},
+		},
+		{
+			name: "duplicate cluster name",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Status.Clusters[1].Cluster = "cluster-a"
+			},
+			expected: []expectedError{{field.ErrorTypeDuplicate, "status.clusters[1].cluster"}},
+		},
+		{
+			name: "invalid condition",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Status.Conditions = []metav1.Condition{{Type: string(v1beta1.ServiceImportConditionReady), Status: "Maybe", Reason: "Pending"}}
+			},
+			expected: []expectedError{
+				{field.ErrorTypeNotSupported, "status.conditions[0].status"},
+				{field.ErrorTypeRequired, "status.conditions[0].lastTransitionTime"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svcImport := validServiceImport()
+			tt.tweak(svcImport)
+			checkErrors(t, ValidateServiceImport(svcImport), tt.expected)
+		})
+	}
+}
+
+func TestValidateServiceImportUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		tweak    func(*v1beta1.ServiceImport)
+		expected []expectedError
+	}{
+		{
+			name: "valid",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.Ports = si.Spec.Ports[:1]
+				si.Status.Clusters = si.Status.Clusters[:1]
+			},
+		},
+		{
+			name: "changed namespace",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Namespace = "other"
+			},
+			expected: []expectedError{{field.ErrorTypeInvalid, "metadata.namespace"}},
+		},
+		{
+			name: "invalid spec",
+			tweak: func(si *v1beta1.ServiceImport) {
+				si.Spec.Type = v1beta1.Headless
+			},
+			expected: []expectedError{{field.ErrorTypeForbidden, "spec.ips"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldSvcImport := validServiceImport()
+			oldSvcImport.ResourceVersion = "1"
+			svcImport := oldSvcImport.DeepCopy()
+			tt.tweak(svcImport)
+			checkErrors(t, ValidateServiceImportUpdate(svcImport, oldSvcImport), tt.expected)
+		})
+	}
+}
+
+func TestValidateServiceExport(t *testing.T) {
+	tests := []struct {
+		name     string
+		tweak    func(*v1beta1.ServiceExport)
+		expected []expectedError
+	}{
+		{
+			name:  "valid",
+			tweak: func(*v1beta1.ServiceExport) {},
+		},
+		{
+			name: "invalid name",
+			tweak: func(se *v1beta1.ServiceExport) {
+				se.Name = "svc.1"
+			},
+			expected: []expectedError{{field.ErrorTypeInvalid, "metadata.name"}},
+		},
+		{
+			name: "invalid source cluster label",
+			tweak: func(se *v1beta1.ServiceExport) {
+				se.Labels = map[string]string{v1beta1.LabelSourceCluster: "cluster_a"}
+			},
+			expected: []expectedError{{field.ErrorTypeInvalid, "metadata.labels[" + v1beta1.LabelSourceCluster + "]"}},
+		},
+		{
+			name: "invalid exported label key",
+			tweak: func(se *v1beta1.ServiceExport) {
+				se.Spec.ExportedLabels["bad key"] = "value"
+			},
+			expected: []expectedError{{field.ErrorTypeInvalid, "spec.exportedLabels"}},
+		},
+		{
+			name: "invalid exported label value",
+			tweak: func(se *v1beta1.ServiceExport) {
+				se.Spec.ExportedLabels["app"] = "bad value"
+			},
+			expected: []expectedError{{field.ErrorTypeInvalid, "spec.exportedLabels"}},
+		},
+		{
+			name: "invalid exported annotation key",
+			tweak: func(se *v1beta1.ServiceExport) {
+				se.Spec.ExportedAnnotations["example.com/bad/key"] = "value"
+			},
+			expected: []expectedError{{field.ErrorTypeInvalid, "spec.exportedAnnotations"}},
+		},
+		{
+			name: "invalid condition",
+			tweak: func(se *v1beta1.ServiceExport) {
+				se.Status.Conditions = []metav1.Condition{{
+					Type:               string(v1beta1.ServiceExportConditionValid),
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.Now(),
+				}}
+			},
+			expected: []expectedError{{field.ErrorTypeRequired, "status.conditions[0].reason"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svcExport := &v1beta1.ServiceExport{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc"},
+				Spec: v1beta1.ServiceExportSpec{
+					ExportedLabels:      map[string]string{"app": "svc"},
+					ExportedAnnotations: map[string]string{"example.com/owner": "team a"},
+				},
+			}
+			tt.tweak(svcExport)
+			checkErrors(t, ValidateServiceExport(svcExport), tt.expected)
+		})
+	}
+}
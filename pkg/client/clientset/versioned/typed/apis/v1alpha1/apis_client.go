@@ -28,6 +28,7 @@ import (
 
 type MulticlusterV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	ClusterPropertiesGetter
 	ServiceExportsGetter
 	ServiceImportsGetter
 }
@@ -37,6 +38,10 @@ type MulticlusterV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *MulticlusterV1alpha1Client) ClusterProperties() ClusterPropertyInterface {
+	return newClusterProperties(c)
+}
+
 func (c *MulticlusterV1alpha1Client) ServiceExports(namespace string) ServiceExportInterface {
 	return newServiceExports(c, namespace)
 }
@@ -28,6 +28,10 @@ type FakeMulticlusterV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeMulticlusterV1alpha1) ClusterProperties() v1alpha1.ClusterPropertyInterface {
+	return newFakeClusterProperties(c)
+}
+
 func (c *FakeMulticlusterV1alpha1) ServiceExports(namespace string) v1alpha1.ServiceExportInterface {
 	return newFakeServiceExports(c, namespace)
 }
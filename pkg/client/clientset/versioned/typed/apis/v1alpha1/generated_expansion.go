@@ -18,6 +18,8 @@ limitations under the License.
 
 package v1alpha1
 
+type ClusterPropertyExpansion interface{}
+
 type ServiceExportExpansion interface{}
 
 type ServiceImportExpansion interface{}
@@ -24,6 +24,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// ClusterProperties returns a ClusterPropertyInformer.
+	ClusterProperties() ClusterPropertyInformer
 	// ServiceExports returns a ServiceExportInformer.
 	ServiceExports() ServiceExportInformer
 	// ServiceImports returns a ServiceImportInformer.
@@ -41,6 +43,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// ClusterProperties returns a ClusterPropertyInformer.
+func (v *version) ClusterProperties() ClusterPropertyInformer {
+	return &clusterPropertyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ServiceExports returns a ServiceExportInformer.
 func (v *version) ServiceExports() ServiceExportInformer {
 	return &serviceExportInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
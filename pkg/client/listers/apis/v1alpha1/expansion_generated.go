@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1alpha1
 
+// ClusterPropertyListerExpansion allows custom methods to be added to
+// ClusterPropertyLister.
+type ClusterPropertyListerExpansion interface{}
+
 // ServiceExportListerExpansion allows custom methods to be added to
 // ServiceExportLister.
 type ServiceExportListerExpansion interface{}
@@ -0,0 +1,223 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conflict merges the services exported by the clusters of a
+// clusterset into a ServiceImport, following the conflict resolution policy of
+// KEP-1645: when the exported services disagree on a property, the value of
+// the oldest ServiceExport wins. Ports are the exception, they are merged and
+// only conflicting ports are resolved in favour of the oldest export.
+//
+// See https://github.com/kubernetes/enhancements/tree/master/keps/sig-multicluster/1645-multi-cluster-services-api#constraints-and-conflict-resolution
+package conflict
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+)
+
+// Export is a service exported from one cluster of the clusterset.
+type Export struct {
+	// Cluster is the name of the exporting cluster.
+	Cluster string
+	// ServiceExport is the export. Its creation timestamp determines which
+	// export wins a conflict.
+	ServiceExport *v1beta1.ServiceExport
+	// Service is the exported Service. It must not be of type ExternalName.
+	Service *v1.Service
+}
+
+// Result is the outcome of merging a set of exports.
+type Result struct {
+	// Spec is the spec of the ServiceImport. IPs are left for the
+	// implementation to allocate.
+	Spec v1beta1.ServiceImportSpec
+	// Clusters lists the exporting clusters, ordered by name.
+	Clusters []v1beta1.ClusterStatus
+	// Labels and Annotations are the exported labels and annotations to set on
+	// the ServiceImport.
+	Labels      map[string]string
+	Annotations map[string]string
+	// Conditions holds the Conflict condition to set on each ServiceExport,
+	// keyed by cluster name.
+	Conditions map[string]metav1.Condition
+}
+
+// property is a property of the exported services that must be consistent
+// across the clusterset, along with the reason used to report a conflict.
+type property struct {
+	reason v1beta1.ServiceExportConditionReason
+	// equal reports whether two exports agree on the property.
+	equal func(a, b *Export) bool
+}
+
+// properties are checked in order, after the ports, which determines the order
+// of the reasons in Conflict conditions.
+var properties = []property{
+	{v1beta1.ServiceExportReasonTypeConflict, func(a, b *Export) bool {
+		return importType(a.Service) == importType(b.Service)
+	}},
+	{v1beta1.ServiceExportReasonSessionAffinityConflict, func(a, b *Export) bool {
+		return a.Service.Spec.SessionAffinity == b.Service.Spec.SessionAffinity
+	}},
+	{v1beta1.ServiceExportReasonSessionAffinityConfigConflict, func(a, b *Export) bool {
+		return apiequality.Semantic.DeepEqual(a.Service.Spec.SessionAffinityConfig, b.Service.Spec.SessionAffinityConfig)
+	}},
+	{v1beta1.ServiceExportReasonIPFamilyConflict, func(a, b *Export) bool {
+		return slices.Equal(sortedFamilies(a.Service), sortedFamilies(b.Service))
+	}},
+	{v1beta1.ServiceExportReasonInternalTrafficPolicyConflict, func(a, b *Export) bool {
+		return ptr.Equal(a.Service.Spec.InternalTrafficPolicy, b.Service.Spec.InternalTrafficPolicy)
+	}},
+	{v1beta1.ServiceExportReasonTrafficDistributionConflict, func(a, b *Export) bool {
+		return ptr.Equal(a.Service.Spec.TrafficDistribution, b.Service.Spec.TrafficDistribution)
+	}},
+	{v1beta1.ServiceExportReasonLabelsConflict, func(a, b *Export) bool {
+		return maps.Equal(a.ServiceExport.Spec.ExportedLabels, b.ServiceExport.Spec.ExportedLabels)
+	}},
+	{v1beta1.ServiceExportReasonAnnotationsConflict, func(a, b *Export) bool {
+		return maps.Equal(a.ServiceExport.Spec.ExportedAnnotations, b.ServiceExport.Spec.ExportedAnnotations)
+	}},
+}
+
+// Resolve merges exports, which must be from distinct clusters, into a
+// ServiceImport. The result only depends on the exports, not on their order.
+func Resolve(exports []Export) Result {
+	result := Result{Conditions: map[string]metav1.Condition{}}
+	if len(exports) == 0 {
+		return result
+	}
+
+	sorted := slices.Clone(exports)
+	slices.SortStableFunc(sorted, func(a, b Export) int {
+		if c := a.ServiceExport.CreationTimestamp.Compare(b.ServiceExport.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Cluster, b.Cluster)
+	})
+	oldest := &sorted[0]
+	ports, portConflict := mergePorts(sorted)
+
+	result.Spec = v1beta1.ServiceImportSpec{
+		Type:                  importType(oldest.Service),
+		Ports:                 ports,
+		SessionAffinity:       oldest.Service.Spec.SessionAffinity,
+		SessionAffinityConfig: oldest.Service.Spec.SessionAffinityConfig.DeepCopy(),
+		IPFamilies:            slices.Clone(oldest.Service.Spec.IPFamilies),
+		InternalTrafficPolicy: copyPtr(oldest.Service.Spec.InternalTrafficPolicy),
+		TrafficDistribution:   copyPtr(oldest.Service.Spec.TrafficDistribution),
+	}
+	result.Labels = maps.Clone(oldest.ServiceExport.Spec.ExportedLabels)
+	result.Annotations = maps.Clone(oldest.ServiceExport.Spec.ExportedAnnotations)
+
+	for i := range sorted {
+		result.Clusters = append(result.Clusters, v1beta1.ClusterStatus{Cluster: sorted[i].Cluster})
+	}
+	slices.SortFunc(result.Clusters, func(a, b v1beta1.ClusterStatus) int {
+		return strings.Compare(a.Cluster, b.Cluster)
+	})
+
+	// A conflicting property is reported on every export, not only on those
+	// whose value lost, since all of them contribute to the conflict.
+	var reasons []string
+	if portConflict {
+		reasons = append(reasons, string(v1beta1.ServiceExportReasonPortConflict))
+	}
+	for _, prop := range properties {
+		for i := range sorted[1:] {
+			if !prop.equal(oldest, &sorted[i+1]) {
+				reasons = append(reasons, string(prop.reason))
+				break
+			}
+		}
+	}
+	for i := range sorted {
+		result.Conditions[sorted[i].Cluster] = conflictCondition(reasons, oldest.Cluster)
+	}
+	return result
+}
+
+func conflictCondition(reasons []string, oldestCluster string) metav1.Condition {
+	if len(reasons) == 0 {
+		return v1beta1.NewServiceExportCondition(v1beta1.ServiceExportConditionConflict, metav1.ConditionFalse,
+			v1beta1.ServiceExportReasonNoConflicts, "")
+	}
+	return v1beta1.NewServiceExportCondition(v1beta1.ServiceExportConditionConflict, metav1.ConditionTrue,
+		v1beta1.ServiceExportConditionReason(strings.Join(reasons, ",")),
+		fmt.Sprintf("The exported services have conflicting properties, the conflicts are resolved in favor of the oldest export, from cluster %q",
+			oldestCluster))
+}
+
+func importType(svc *v1.Service) v1beta1.ServiceImportType {
+	if svc.Spec.ClusterIP == v1.ClusterIPNone {
+		return v1beta1.Headless
+	}
+	return v1beta1.ClusterSetIP
+}
+
+func servicePorts(svc *v1.Service) []v1beta1.ServicePort {
+	ports := make([]v1beta1.ServicePort, len(svc.Spec.Ports))
+	for i, p := range svc.Spec.Ports {
+		ports[i] = v1beta1.ServicePort{
+			Name:        p.Name,
+			Protocol:    p.Protocol,
+			AppProtocol: p.AppProtocol,
+			Port:        p.Port,
+		}
+	}
+	return ports
+}
+
+// mergePorts returns the union of the ports of exports, which are ordered from
+// oldest to newest. A port that has the same name, or the same number and
+// protocol, as a port of an older export is left out, and is a conflict unless
+// it is identical to that port. Ports that only some of the exports have are
+// not a conflict.
+func mergePorts(exports []Export) ([]v1beta1.ServicePort, bool) {
+	var merged []v1beta1.ServicePort
+	conflict := false
+	for i := range exports {
+		for _, port := range servicePorts(exports[i].Service) {
+			j := slices.IndexFunc(merged, func(p v1beta1.ServicePort) bool {
+				return p.Name == port.Name || (p.Port == port.Port && p.Protocol == port.Protocol)
+			})
+			if j < 0 {
+				merged = append(merged, port)
+			} else if !apiequality.Semantic.DeepEqual(merged[j], port) {
+				conflict = true
+			}
+		}
+	}
+	return merged, conflict
+}
+
+func copyPtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	return ptr.To(*p)
+}
+
+func sortedFamilies(svc *v1.Service) []v1.IPFamily {
+	return slices.Sorted(slices.Values(svc.Spec.IPFamilies))
+}
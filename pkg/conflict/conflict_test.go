@@ -0,0 +1,345 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conflict
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/mcs-api/pkg/apis/v1beta1"
+)
+
+var now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// newExport returns an export from cluster created age ago.
+func newExport(cluster string, age time.Duration) Export {
+	return Export{
+		Cluster: cluster,
+		ServiceExport: &v1beta1.ServiceExport{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "ns",
+				Name:              "svc",
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+			Spec: v1beta1.ServiceExportSpec{
+				ExportedLabels:      map[string]string{"app": "svc"},
+				ExportedAnnotations: map[string]string{"example.com/owner": "team-a"},
+			},
+		},
+		Service: &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc"},
+			Spec: v1.ServiceSpec{
+				Type:            v1.ServiceTypeClusterIP,
+				ClusterIP:       "10.0.0.1",
+				Ports:           []v1.ServicePort{{Name: "http", Protocol: v1.ProtocolTCP, Port: 80}},
+				SessionAffinity: v1.ServiceAffinityNone,
+				IPFamilies:      []v1.IPFamily{v1.IPv4Protocol},
+			},
+		},
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name string
+		// tweak modifies the newer of two exports, from cluster "b", which is
+		// created after the export from cluster "a".
+		tweak          func(*Export)
+		expectedReason v1beta1.ServiceExportConditionReason
+		expectedSpec   func(*v1beta1.ServiceImportSpec)
+	}{
+		{
+			name:           "identical exports",
+			tweak:          func(*Export) {},
+			expectedReason: v1beta1.ServiceExportReasonNoConflicts,
+		},
+		{
+			name: "differing Services with the same exported properties",
+			tweak: func(e *Export) {
+				e.Service.Spec.ClusterIP = "10.0.0.2"
+				e.Service.Labels = map[string]string{"other": "label"}
+			},
+			expectedReason: v1beta1.ServiceExportReasonNoConflicts,
+		},
+		{
+			name: "additional port",
+			tweak: func(e *Export) {
+				e.Service.Spec.Ports = append(e.Service.Spec.Ports, v1.ServicePort{Name: "sctp", Protocol: v1.ProtocolSCTP, Port: 142})
+			},
+			expectedReason: v1beta1.ServiceExportReasonNoConflicts,
+			expectedSpec: func(spec *v1beta1.ServiceImportSpec) {
+				spec.Ports = append(spec.Ports, v1beta1.ServicePort{Name: "sctp", Protocol: v1.ProtocolSCTP, Port: 142})
+			},
+		},
+		{
+			name: "missing port",
+			tweak: func(e *Export) {
+				e.Service.Spec.Ports = []v1.ServicePort{{Name: "sctp", Protocol: v1.ProtocolSCTP, Port: 142}}
+			},
+			expectedReason: v1beta1.ServiceExportReasonNoConflicts,
+			expectedSpec: func(spec *v1beta1.ServiceImportSpec) {
+				spec.Ports = append(spec.Ports, v1beta1.ServicePort{Name: "sctp", Protocol: v1.ProtocolSCTP, Port: 142})
+			},
+		},
+		{
+			name: "port with the same name",
+			tweak: func(e *Export) {
+				e.Service.Spec.Ports[0].Port = 81
+			},
+			expectedReason: v1beta1.ServiceExportReasonPortConflict,
+		},
+		{
+			name: "port with the same number and protocol",
+			tweak: func(e *Export) {
+				e.Service.Spec.Ports[0].Name = "web"
+			},
+			expectedReason: v1beta1.ServiceExportReasonPortConflict,
+		},
+		{
+			name: "port with a different app protocol",
+			tweak: func(e *Export) {
+				e.Service.Spec.Ports[0].AppProtocol = ptr.To("kubernetes.io/h2c")
+			},
+			expectedReason: v1beta1.ServiceExportReasonPortConflict,
+		},
+		{
+			name: "headless",
+			tweak: func(e *Export) {
+				e.Service.Spec.ClusterIP = v1.ClusterIPNone
+			},
+			expectedReason: v1beta1.ServiceExportReasonTypeConflict,
+		},
+		{
+			name: "session affinity",
+			tweak: func(e *Export) {
+				e.Service.Spec.SessionAffinity = v1.ServiceAffinityClientIP
+			},
+			expectedReason: v1beta1.ServiceExportReasonSessionAffinityConflict,
+		},
+		{
+			name: "session affinity config",
+			tweak: func(e *Export) {
+				e.Service.Spec.SessionAffinityConfig = &v1.SessionAffinityConfig{
+					ClientIP: &v1.ClientIPConfig{TimeoutSeconds: ptr.To[int32](10)},
+				}
+			},
+			expectedReason: v1beta1.ServiceExportReasonSessionAffinityConfigConflict,
+		},
+		{
+			name: "IP families",
+			tweak: func(e *Export) {
+				e.Service.Spec.IPFamilies = []v1.IPFamily{v1.IPv4Protocol, v1.IPv6Protocol}
+			},
+			expectedReason: v1beta1.ServiceExportReasonIPFamilyConflict,
+		},
+		{
+			name: "IP families in a different order",
+			tweak: func(e *Export) {
+				e.Service.Spec.IPFamilies = []v1.IPFamily{v1.IPv6Protocol, v1.IPv4Protocol}
+			},
+			expectedReason: v1beta1.ServiceExportReasonIPFamilyConflict,
+		},
+		{
+			name: "internal traffic policy",
+			tweak: func(e *Export) {
+				e.Service.Spec.InternalTrafficPolicy = ptr.To(v1.ServiceInternalTrafficPolicyLocal)
+			},
+			expectedReason: v1beta1.ServiceExportReasonInternalTrafficPolicyConflict,
+		},
+		{
+			name: "traffic distribution",
+			tweak: func(e *Export) {
+				e.Service.Spec.TrafficDistribution = ptr.To(v1.ServiceTrafficDistributionPreferClose)
+			},
+			expectedReason: v1beta1.ServiceExportReasonTrafficDistributionConflict,
+		},
+		{
+			name: "exported labels",
+			tweak: func(e *Export) {
+				e.ServiceExport.Spec.ExportedLabels["tier"] = "backend"
+			},
+			expectedReason: v1beta1.ServiceExportReasonLabelsConflict,
+		},
+		{
+			name: "exported annotations",
+			tweak: func(e *Export) {
+				e.ServiceExport.Spec.ExportedAnnotations = nil
+			},
+			expectedReason: v1beta1.ServiceExportReasonAnnotationsConflict,
+		},
+		{
+			name: "several properties",
+			tweak: func(e *Export) {
+				e.Service.Spec.ClusterIP = v1.ClusterIPNone
+				e.Service.Spec.Ports[0].Port = 81
+				e.ServiceExport.Spec.ExportedLabels = nil
+			},
+			expectedReason: "PortConflict,TypeConflict,LabelsConflict",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			older := newExport("a", time.Hour)
+			newer := newExport("b", time.Minute)
+			tt.tweak(&newer)
+
+			expectedSpec := v1beta1.ServiceImportSpec{
+				Type:            v1beta1.ClusterSetIP,
+				Ports:           []v1beta1.ServicePort{{Name: "http", Protocol: v1.ProtocolTCP, Port: 80}},
+				SessionAffinity: v1.ServiceAffinityNone,
+				IPFamilies:      []v1.IPFamily{v1.IPv4Protocol},
+			}
+			if tt.expectedSpec != nil {
+				tt.expectedSpec(&expectedSpec)
+			}
+
+			// The result must not depend on the order of the exports.
+			for _, exports := range [][]Export{{older, newer}, {newer, older}} {
+				result := Resolve(exports)
+
+				if !apiequality.Semantic.DeepEqual(result.Spec, expectedSpec) {
+					t.Errorf("expected spec %+v, got %+v", expectedSpec, result.Spec)
+				}
+				if !apiequality.Semantic.DeepEqual(result.Labels, older.ServiceExport.Spec.ExportedLabels) {
+					t.Errorf("expected labels %v, got %v", older.ServiceExport.Spec.ExportedLabels, result.Labels)
+				}
+				if !apiequality.Semantic.DeepEqual(result.Annotations, older.ServiceExport.Spec.ExportedAnnotations) {
+					t.Errorf("expected annotations %v, got %v", older.ServiceExport.Spec.ExportedAnnotations, result.Annotations)
+				}
+				if !slices.Equal(result.Clusters, []v1beta1.ClusterStatus{{Cluster: "a"}, {Cluster: "b"}}) {
+					t.Errorf("expected clusters a and b, got %v", result.Clusters)
+				}
+
+				expectedStatus := metav1.ConditionTrue
+				if tt.expectedReason == v1beta1.ServiceExportReasonNoConflicts {
+					expectedStatus = metav1.ConditionFalse
+				}
+				if len(result.Conditions) != 2 {
+					t.Fatalf("expected a condition for each cluster, got %v", result.Conditions)
+				}
+				for cluster, condition := range result.Conditions {
+					if condition.Type != string(v1beta1.ServiceExportConditionConflict) || condition.Status != expectedStatus ||
+						condition.Reason != string(tt.expectedReason) {
+						t.Errorf("expected %s Conflict condition with reason %q on cluster %q, got %+v",
+							expectedStatus, tt.expectedReason, cluster, condition)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestResolveOldestWins(t *testing.T) {
+	oldest := newExport("c", time.Hour)
+	tied := newExport("b", 30*time.Minute)
+	tied.Service.Spec.ClusterIP = v1.ClusterIPNone
+	tied.Service.Spec.Ports[0].Port = 8080
+	tiedWinner := newExport("a", 30*time.Minute)
+	tiedWinner.Service.Spec.SessionAffinity = v1.ServiceAffinityClientIP
+	tiedWinner.Service.Spec.Ports = append(tiedWinner.Service.Spec.Ports,
+		v1.ServicePort{Name: "dns", Protocol: v1.ProtocolUDP, Port: 53})
+	tied.Service.Spec.Ports = append(tied.Service.Spec.Ports,
+		v1.ServicePort{Name: "dns-tcp", Protocol: v1.ProtocolTCP, Port: 53},
+		v1.ServicePort{Name: "other-dns", Protocol: v1.ProtocolUDP, Port: 53})
+
+	result := Resolve([]Export{tied, oldest, tiedWinner})
+
+	if result.Spec.Type != v1beta1.ClusterSetIP {
+		t.Errorf("expected the type of the oldest export, got %q", result.Spec.Type)
+	}
+	if result.Spec.SessionAffinity != v1.ServiceAffinityNone {
+		t.Errorf("expected the session affinity of the oldest export, got %q", result.Spec.SessionAffinity)
+	}
+	// Exports created at the same time are ordered by cluster name.
+	expectedPorts := []v1beta1.ServicePort{
+		{Name: "http", Protocol: v1.ProtocolTCP, Port: 80},
+		{Name: "dns", Protocol: v1.ProtocolUDP, Port: 53},
+		{Name: "dns-tcp", Protocol: v1.ProtocolTCP, Port: 53},
+	}
+	if !slices.Equal(result.Spec.Ports, expectedPorts) {
+		t.Errorf("expected ports %v, got %v", expectedPorts, result.Spec.Ports)
+	}
+	if !slices.Equal(result.Clusters, []v1beta1.ClusterStatus{{Cluster: "a"}, {Cluster: "b"}, {Cluster: "c"}}) {
+		t.Errorf("expected clusters ordered by name, got %v", result.Clusters)
+	}
+	for cluster, condition := range result.Conditions {
+		if condition.Reason != "PortConflict,TypeConflict,SessionAffinityConflict" {
+			t.Errorf("unexpected condition on cluster %q: %+v", cluster, condition)
+		}
+	}
+}
+
+func TestResolvePortConflictBetweenNewerExports(t *testing.T) {
+	oldest := newExport("a", time.Hour)
+	older := newExport("b", 30*time.Minute)
+	older.Service.Spec.Ports = append(older.Service.Spec.Ports, v1.ServicePort{Name: "dns", Protocol: v1.ProtocolUDP, Port: 53})
+	newer := newExport("c", time.Minute)
+	newer.Service.Spec.Ports = []v1.ServicePort{{Name: "dns", Protocol: v1.ProtocolUDP, Port: 5353}}
+
+	result := Resolve([]Export{newer, older, oldest})
+
+	expectedPorts := []v1beta1.ServicePort{
+		{Name: "http", Protocol: v1.ProtocolTCP, Port: 80},
+		{Name: "dns", Protocol: v1.ProtocolUDP, Port: 53},
+	}
+	if !slices.Equal(result.Spec.Ports, expectedPorts) {
+		t.Errorf("expected ports %v, got %v", expectedPorts, result.Spec.Ports)
+	}
+	for cluster, condition := range result.Conditions {
+		if condition.Reason != string(v1beta1.ServiceExportReasonPortConflict) {
+			t.Errorf("expected a port conflict on cluster %q, got %+v", cluster, condition)
+		}
+	}
+}
+
+func TestResolveSingleExport(t *testing.T) {
+	export := newExport("a", time.Hour)
+	export.Service.Spec.SessionAffinity = v1.ServiceAffinityClientIP
+	export.Service.Spec.SessionAffinityConfig = &v1.SessionAffinityConfig{
+		ClientIP: &v1.ClientIPConfig{TimeoutSeconds: ptr.To[int32](10)},
+	}
+	export.Service.Spec.TrafficDistribution = ptr.To(v1.ServiceTrafficDistributionPreferClose)
+
+	result := Resolve([]Export{export})
+
+	if !apiequality.Semantic.DeepEqual(result.Spec.SessionAffinityConfig, export.Service.Spec.SessionAffinityConfig) {
+		t.Errorf("expected session affinity config %v, got %v", export.Service.Spec.SessionAffinityConfig, result.Spec.SessionAffinityConfig)
+	}
+	if result.Spec.SessionAffinityConfig == export.Service.Spec.SessionAffinityConfig {
+		t.Error("expected the session affinity config to be copied")
+	}
+	if !ptr.Equal(result.Spec.TrafficDistribution, export.Service.Spec.TrafficDistribution) {
+		t.Errorf("expected traffic distribution %v, got %v", export.Service.Spec.TrafficDistribution, result.Spec.TrafficDistribution)
+	}
+	if condition := result.Conditions["a"]; condition.Status != metav1.ConditionFalse ||
+		condition.Reason != string(v1beta1.ServiceExportReasonNoConflicts) {
+		t.Errorf("expected no conflicts, got %+v", condition)
+	}
+}
+
+func TestResolveNoExports(t *testing.T) {
+	result := Resolve(nil)
+	if len(result.Spec.Ports) != 0 || len(result.Clusters) != 0 || len(result.Conditions) != 0 {
+		t.Errorf("expected an empty result, got %+v", result)
+	}
+}
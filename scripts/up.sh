@@ -91,10 +91,38 @@ echo "Cluster networks connected"
 ${k1} apply -f ../config/rbac
 ${k2} apply -f ../config/rbac
 
-${k1} create sa mcs-api-controller
-${k1} create clusterrolebinding mcs-api-binding --clusterrole=mcs-derived-service-manager --serviceaccount=default:mcs-api-controller
-${k1} run --image "${controller_image}" --image-pull-policy=Never mcs-api-controller --overrides='{ "spec": { "serviceAccount": "mcs-api-controller" }  }'
-
-${k2} create sa mcs-api-controller
-${k2} create clusterrolebinding mcs-api-binding --clusterrole=mcs-derived-service-manager --serviceaccount=default:mcs-api-controller
-${k2} run --image "${controller_image}" --image-pull-policy=Never mcs-api-controller --overrides='{ "spec": { "serviceAccount": "mcs-api-controller" }  }'
+function run_controller() {
+  ${1} create sa mcs-api-controller
+  ${1} create clusterrolebinding mcs-api-binding --clusterrole=mcs-derived-service-manager --serviceaccount=default:mcs-api-controller
+  if [ -z "${ENABLE_WEBHOOKS}" ]; then
+    ${1} run --image "${controller_image}" --image-pull-policy=Never mcs-api-controller --overrides='{ "spec": { "serviceAccount": "mcs-api-controller" }  }'
+    return
+  fi
+
+  # Serve the CRD conversion webhook with a self-signed certificate, and
+  # switch the CRDs to it.
+  local certs=$(mktemp -d)
+  openssl req -x509 -newkey rsa:2048 -nodes -days 365 -subj "/CN=mcs-api-controller.default.svc" \
+    -addext "subjectAltName=DNS:mcs-api-controller.default.svc" \
+    -keyout "${certs}/tls.key" -out "${certs}/tls.crt" 2>/dev/null
+  ${1} create secret tls mcs-api-controller-webhook --cert "${certs}/tls.crt" --key "${certs}/tls.key"
+  ${1} run --image "${controller_image}" --image-pull-policy=Never mcs-api-controller --overrides='{ "spec": {
+    "serviceAccount": "mcs-api-controller",
+    "containers": [{
+      "name": "mcs-api-controller", "image": "'"${controller_image}"'", "imagePullPolicy": "Never",
+      "args": ["--enable-webhooks"],
+      "volumeMounts": [{ "name": "webhook-cert", "mountPath": "/tmp/k8s-webhook-server/serving-certs", "readOnly": true }]
+    }],
+    "volumes": [{ "name": "webhook-cert", "secret": { "secretName": "mcs-api-controller-webhook" } }]
+  } }'
+  ${1} expose pod mcs-api-controller --port 443 --target-port 9443
+  local ca_bundle=$(base64 < "${certs}/tls.crt" | tr -d '\n')
+  for crd in serviceexports serviceimports; do
+    ${1} patch crd "${crd}.multicluster.x-k8s.io" --type merge --patch-file ../config/webhook/crd-conversion-patch.yaml
+    ${1} patch crd "${crd}.multicluster.x-k8s.io" --type json \
+      --patch '[{ "op": "add", "path": "/spec/conversion/webhook/clientConfig/caBundle", "value": "'"${ca_bundle}"'" }]'
+  done
+  rm -rf "${certs}"
+}
+run_controller "${k1}"
+run_controller "${k2}"